@@ -31,6 +31,7 @@ import (
 	"github.com/vulcanize/dxns/x/auction"
 	"github.com/vulcanize/dxns/x/bond"
 	ns "github.com/vulcanize/dxns/x/nameservice"
+	nsclient "github.com/vulcanize/dxns/x/nameservice/client"
 
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
@@ -59,6 +60,7 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distr.ProposalHandler, upgradeclient.ProposalHandler,
+			nsclient.ProposalHandler, nsclient.AuthorityDisputeProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -227,6 +229,7 @@ func NewAppInit(
 		app.accountKeeper,
 		app.bankKeeper,
 		app.supplyKeeper,
+		app.DistrKeeper,
 		keys[auction.StoreKey],
 		app.cdc,
 		app.subspaces[auction.ModuleName],
@@ -274,7 +277,8 @@ func NewAppInit(
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(ns.RouterKey, ns.NewProposalHandler(app.nsKeeper))
 
 	app.govKeeper = gov.NewKeeper(
 		cdc, keys[gov.StoreKey], app.subspaces[gov.ModuleName], app.supplyKeeper,
@@ -301,6 +305,7 @@ func NewAppInit(
 		distr.NewAppModule(app.DistrKeeper, app.accountKeeper, app.supplyKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
 		evidence.NewAppModule(app.evidenceKeeper),
+		upgrade.NewAppModule(app.upgradeKeeper),
 		bond.NewAppModule(app.bondKeeper),
 		auction.NewAppModule(app.auctionKeeper),
 		ns.NewAppModule(app.nsKeeper),
@@ -310,7 +315,7 @@ func NewAppInit(
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	app.mm.SetOrderBeginBlockers(
-		mint.ModuleName, distr.ModuleName, slashing.ModuleName,
+		upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName,
 		evidence.ModuleName,
 	)
 
@@ -349,6 +354,8 @@ func NewAppInit(
 
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.registerUpgradeHandlers()
+
 	if loadLatest {
 		err := app.LoadLatestVersion(app.keys[bam.MainStoreKey])
 		if err != nil {
@@ -0,0 +1,39 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+// StoreMigrationUpgradeName is the name of the software upgrade (plan) that migrates existing chain state.
+const StoreMigrationUpgradeName = "store-migration"
+
+// registerUpgradeHandlers registers the state migrations run by software upgrades.
+func (app *NewApp) registerUpgradeHandlers() {
+	app.upgradeKeeper.SetUpgradeHandler(StoreMigrationUpgradeName, func(ctx sdk.Context, plan upgrade.Plan) {
+		// Set the auction params added after launch to the defaults.
+		app.auctionKeeper.MigrateParams(ctx)
+
+		// Set the nameservice params added after launch to the defaults.
+		app.nsKeeper.MigrateParams(ctx)
+
+		// Move authorities and names registered before names were normalized.
+		app.nsKeeper.MigrateNames(ctx)
+
+		// Index the attributes of records created before attributes were indexed.
+		app.nsKeeper.MigrateAttributeIndexes(ctx)
+
+		// Index the references of records created before references were indexed.
+		app.nsKeeper.MigrateReferenceIndexes(ctx)
+
+		// Drop the record schemas registered before record types were scoped to authorities.
+		app.nsKeeper.MigrateRecordSchemas(ctx)
+
+		// Expire the approvals pending from before approvals expired.
+		app.nsKeeper.MigrateApprovals(ctx)
+	})
+}
@@ -32,6 +32,20 @@ func (r *Resolver) Query() baseGql.QueryResolver {
 	return &queryResolver{r}
 }
 
+// Record is the entry point to record field resolution.
+func (r *Resolver) Record() baseGql.RecordResolver {
+	return &recordResolver{r}
+}
+
+type recordResolver struct{ *Resolver }
+
+// ReferencedBy resolves the records referencing a record.
+func (r *recordResolver) ReferencedBy(ctx context.Context, obj *baseGql.Record) ([]*baseGql.Record, error) {
+	ids := r.Keeper.GetReferencingRecordIDs(nameservice.ID(obj.ID))
+
+	return baseGql.GetReferencingRecords(ctx, r.Query(), ids)
+}
+
 func (r *queryResolver) GetRecordsByIds(ctx context.Context, ids []string) ([]*baseGql.Record, error) {
 	records := make([]*baseGql.Record, len(ids))
 	for index, id := range ids {
@@ -47,10 +61,20 @@ func (r *queryResolver) GetRecordsByIds(ctx context.Context, ids []string) ([]*b
 }
 
 // QueryRecords filters records by K=V conditions.
-func (r *queryResolver) QueryRecords(ctx context.Context, attributes []*baseGql.KeyValueInput, all *bool) ([]*baseGql.Record, error) {
-	var records = r.Keeper.MatchRecords(func(record *nameservice.Record) bool {
+func (r *queryResolver) QueryRecords(ctx context.Context, attributes []*baseGql.KeyValueInput, all *bool, limit *int, after *string) ([]*baseGql.Record, error) {
+	pagination := baseGql.GetPagination(limit, after)
+
+	matchFn := func(record *nameservice.Record) bool {
 		return baseGql.MatchOnAttributes(record, attributes, (all != nil && *all))
-	})
+	}
+
+	// Use a secondary index, if possible, to avoid scanning all records.
+	var records []*nameservice.Record
+	if key, value, found := baseGql.GetIndexedAttribute(attributes); found {
+		records = r.Keeper.MatchIndexedRecords(key, value, matchFn, pagination)
+	} else {
+		records = r.Keeper.MatchRecords(matchFn, pagination)
+	}
 
 	return baseGql.QueryRecords(ctx, r, records, attributes)
 }
@@ -124,6 +148,12 @@ func (r *queryResolver) LookupNames(ctx context.Context, names []string) (*baseG
 			return nil, err
 		}
 
+		if record != nil && record.Alias != "" {
+			if chain, err := r.Keeper.GetAliasChain(name); err == nil {
+				gqlRecord.AliasChain = baseGql.GetGQLAliasChain(chain)
+			}
+		}
+
 		gqlResponse = append(gqlResponse, gqlRecord)
 	}
 
@@ -194,3 +224,19 @@ func (r *queryResolver) GetAuctionsByIds(ctx context.Context, ids []string) ([]*
 
 	return gqlResponse, nil
 }
+
+func (r *queryResolver) GetOpenAuctions(ctx context.Context, limit *int, after *string) ([]*baseGql.Auction, error) {
+	gqlResponse := []*baseGql.Auction{}
+
+	for _, auctionObj := range r.Keeper.ListOpenAuctions(baseGql.GetPagination(limit, after)) {
+		bids := r.Keeper.GetBids(auctionObj.ID)
+		gqlAuction, err := baseGql.GetGQLAuction(ctx, r, auctionObj, bids)
+		if err != nil {
+			return nil, err
+		}
+
+		gqlResponse = append(gqlResponse, gqlAuction)
+	}
+
+	return gqlResponse, nil
+}
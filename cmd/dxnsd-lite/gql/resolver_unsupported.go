@@ -48,7 +48,7 @@ func (r *queryResolver) GetBond(ctx context.Context, id string) (*baseGql.Bond,
 	return nil, errors.New("Not supported")
 }
 
-func (r *queryResolver) QueryBonds(ctx context.Context, attributes []*baseGql.KeyValueInput) ([]*baseGql.Bond, error) {
+func (r *queryResolver) QueryBonds(ctx context.Context, attributes []*baseGql.KeyValueInput, limit *int, after *string) ([]*baseGql.Bond, error) {
 	// Only supported by a full-node.
 	return nil, errors.New("Not supported")
 }
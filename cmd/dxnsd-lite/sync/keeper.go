@@ -7,6 +7,7 @@ package sync
 import (
 	"github.com/cosmos/cosmos-sdk/store"
 	"github.com/tendermint/go-amino"
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/auction"
 	ns "github.com/vulcanize/dxns/x/nameservice"
 )
@@ -54,6 +55,23 @@ func (k Keeper) SaveStatus(status Status) {
 	k.store.Set(ns.KeySyncStatus, bz)
 }
 
+// GetIndexVersion gets the version of the node-local record indexes (0 => not set).
+func (k Keeper) GetIndexVersion() int64 {
+	var version int64
+	if bz := k.store.Get(ns.KeySyncIndexVersion); bz != nil {
+		k.codec.MustUnmarshalBinaryBare(bz, &version)
+	}
+
+	return version
+}
+
+// Reindex rebuilds the node-local record indexes, e.g. for records synced by older node versions.
+func (k Keeper) Reindex() {
+	ns.ReindexRecordAttributes(k.store, k.codec)
+	ns.ReindexRecordReferences(k.store, k.codec)
+	k.store.Set(ns.KeySyncIndexVersion, k.codec.MustMarshalBinaryBare(IndexVersion))
+}
+
 // HasRecord - checks if a record by the given ID exists.
 func (k Keeper) HasRecord(id ns.ID) bool {
 	return ns.HasRecord(k.store, id)
@@ -67,6 +85,10 @@ func (k Keeper) GetRecord(id ns.ID) ns.Record {
 // PutRecord - saves a record to the store and updates ID -> Record index.
 func (k Keeper) PutRecord(record ns.RecordObj) {
 	k.store.Set(ns.GetRecordIndexKey(record.ID), k.codec.MustMarshalBinaryBare(record))
+
+	// Maintain node-local attribute -> [Record] and record -> [referencing Record] indexes.
+	ns.AddRecordToAttributeIndexes(k.store, record.ToRecord())
+	ns.AddRecordToReferenceIndex(k.store, record.ToRecord())
 }
 
 // SetNameAuthorityRecord - sets a name authority record.
@@ -81,11 +103,16 @@ func (k Keeper) SetNameRecordRaw(wrn string, nameRecord ns.NameRecord) {
 
 // SetNameRecord - sets a name record.
 func (k Keeper) SetNameRecord(wrn string, nameRecord ns.NameRecord) {
-	ns.SetNameRecord(k.store, k.codec, wrn, nameRecord.ID, nameRecord.Height)
+	ns.SetNameRecordEntry(k.store, k.codec, wrn, nameRecord.NameRecordEntry)
 }
 
 // ResolveWRN resolves a WRN to a record.
 func (k Keeper) ResolveWRN(wrn string) *ns.Record {
+	wrn, err := ns.NormalizeWRN(wrn)
+	if err != nil {
+		return nil
+	}
+
 	record, _ := ns.ResolveWRN(k.store, k.codec, wrn)
 
 	return record
@@ -93,17 +120,47 @@ func (k Keeper) ResolveWRN(wrn string) *ns.Record {
 
 // GetNameAuthority get the name authority record for an authority name.
 func (k Keeper) GetNameAuthority(name string) *ns.NameAuthority {
+	name, err := ns.NormalizeName(name)
+	if err != nil {
+		return nil
+	}
+
 	return ns.GetNameAuthority(k.store, k.codec, name)
 }
 
 // GetNameRecord get the name record for a name/WRN.
 func (k Keeper) GetNameRecord(name string) *ns.NameRecord {
+	name, err := ns.NormalizeWRN(name)
+	if err != nil {
+		return nil
+	}
+
 	return ns.GetNameRecord(k.store, k.codec, name)
 }
 
+// GetAliasChain returns the WRNs followed when resolving a name, starting with the name itself.
+func (k Keeper) GetAliasChain(name string) ([]string, error) {
+	name, err := ns.NormalizeWRN(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return ns.GetAliasChain(k.store, k.codec, name)
+}
+
+// GetReferencingRecordIDs - get the IDs of the records referencing the given record.
+func (k Keeper) GetReferencingRecordIDs(id ns.ID) []ns.ID {
+	return ns.GetReferencingRecordIDs(k.store, id)
+}
+
 // MatchRecords - get all matching records.
-func (k Keeper) MatchRecords(matchFn func(*ns.Record) bool) []*ns.Record {
-	return ns.MatchRecords(k.store, k.codec, matchFn)
+func (k Keeper) MatchRecords(matchFn func(*ns.Record) bool, pagination wnsUtils.Pagination) []*ns.Record {
+	return ns.MatchRecords(k.store, k.codec, matchFn, pagination)
+}
+
+// MatchIndexedRecords - get all matching records, from the records having the given (indexed) attribute value.
+func (k Keeper) MatchIndexedRecords(key string, value string, matchFn func(*ns.Record) bool, pagination wnsUtils.Pagination) []*ns.Record {
+	return ns.MatchIndexedRecords(k.store, k.codec, key, value, matchFn, pagination)
 }
 
 // GetAuction get the auction record.
@@ -116,6 +173,13 @@ func (k Keeper) GetBids(id auction.ID) []*auction.Bid {
 	return auction.GetBids(k.store, k.codec, id)
 }
 
+// ListOpenAuctions - get auctions in the commit or reveal phase (page).
+func (k Keeper) ListOpenAuctions(pagination wnsUtils.Pagination) []*auction.Auction {
+	return auction.MatchAuctions(k.store, k.codec, func(auctionObj *auction.Auction) bool {
+		return auctionObj.IsOpen()
+	}, pagination)
+}
+
 // SaveAuction - saves an auction record.
 func (k Keeper) SaveAuction(auctionObj auction.Auction) {
 	// Auction ID -> Auction index.
@@ -36,6 +36,9 @@ const DumpRPCNodeStatsFrequencyMillis = 60 * 1000
 // DiscoverRPCNodesFrequencyMillis controls frequency to discover new RPC endpoints.
 const DiscoverRPCNodesFrequencyMillis = 60 * 1000
 
+// IndexVersion is the version of the node-local record indexes, bumped when existing records need reindexing.
+const IndexVersion int64 = 2
+
 // Init sets up the lite node.
 func Init(ctx *Context, height int64) {
 	// If sync record exists, abort with error.
@@ -61,6 +64,12 @@ func Start(ctx *Context) {
 		ctx.log.Fatalln("Node not initialized, aborting.")
 	}
 
+	// Rebuild indexes missing from records synced by older node versions.
+	if ctx.keeper.GetIndexVersion() < IndexVersion {
+		ctx.log.Infoln("Rebuilding record indexes.")
+		ctx.keeper.Reindex()
+	}
+
 	go dumpConnectionStatsOnTimer(ctx)
 
 	if ctx.config.SyncTimeoutMins > 0 {
@@ -182,6 +191,12 @@ func (rpc *RPCNodeHandler) syncRecords(ctx *Context, height int64, records []ns.
 		}
 
 		ctx.cache.Set(recordKey, value)
+
+		// Update attribute -> [Record] and record -> [referencing Record] indexes.
+		var record ns.RecordObj
+		ctx.codec.MustUnmarshalBinaryBare(value, &record)
+		ns.AddRecordToAttributeIndexes(ctx.cache, record.ToRecord())
+		ns.AddRecordToReferenceIndex(ctx.cache, record.ToRecord())
 	}
 
 	return nil
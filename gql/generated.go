@@ -36,6 +36,7 @@ type Config struct {
 type ResolverRoot interface {
 	Mutation() MutationResolver
 	Query() QueryResolver
+	Record() RecordResolver
 }
 
 type DirectiveRoot struct {
@@ -51,19 +52,25 @@ type ComplexityRoot struct {
 	}
 
 	Auction struct {
-		Bids           func(childComplexity int) int
-		CommitFee      func(childComplexity int) int
-		CommitsEndTime func(childComplexity int) int
-		CreateTime     func(childComplexity int) int
-		ID             func(childComplexity int) int
-		MinimumBid     func(childComplexity int) int
-		OwnerAddress   func(childComplexity int) int
-		RevealFee      func(childComplexity int) int
-		RevealsEndTime func(childComplexity int) int
-		Status         func(childComplexity int) int
-		WinnerAddress  func(childComplexity int) int
-		WinnerBid      func(childComplexity int) int
-		WinnerPrice    func(childComplexity int) int
+		BidIncrement    func(childComplexity int) int
+		Bids            func(childComplexity int) int
+		CommitFee       func(childComplexity int) int
+		CommitsEndTime  func(childComplexity int) int
+		CreateTime      func(childComplexity int) int
+		ExtensionWindow func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Kind            func(childComplexity int) int
+		MinimumBid      func(childComplexity int) int
+		OwnerAddress    func(childComplexity int) int
+		PriceDecrement  func(childComplexity int) int
+		Proceeds        func(childComplexity int) int
+		RevealFee       func(childComplexity int) int
+		RevealsEndTime  func(childComplexity int) int
+		StartPrice      func(childComplexity int) int
+		Status          func(childComplexity int) int
+		WinnerAddress   func(childComplexity int) int
+		WinnerBid       func(childComplexity int) int
+		WinnerPrice     func(childComplexity int) int
 	}
 
 	AuctionBid struct {
@@ -78,13 +85,14 @@ type ComplexityRoot struct {
 	}
 
 	AuthorityRecord struct {
-		Auction        func(childComplexity int) int
-		BondID         func(childComplexity int) int
-		ExpiryTime     func(childComplexity int) int
-		Height         func(childComplexity int) int
-		OwnerAddress   func(childComplexity int) int
-		OwnerPublicKey func(childComplexity int) int
-		Status         func(childComplexity int) int
+		Auction             func(childComplexity int) int
+		BondID              func(childComplexity int) int
+		ExpiryTime          func(childComplexity int) int
+		Height              func(childComplexity int) int
+		OwnerAddress        func(childComplexity int) int
+		OwnerPublicKey      func(childComplexity int) int
+		PendingOwnerAddress func(childComplexity int) int
+		Status              func(childComplexity int) int
 	}
 
 	AuthorityResult struct {
@@ -114,11 +122,13 @@ type ComplexityRoot struct {
 	}
 
 	NameRecord struct {
-		History func(childComplexity int) int
-		Latest  func(childComplexity int) int
+		AliasChain func(childComplexity int) int
+		History    func(childComplexity int) int
+		Latest     func(childComplexity int) int
 	}
 
 	NameRecordEntry struct {
+		Alias  func(childComplexity int) int
 		Height func(childComplexity int) int
 		ID     func(childComplexity int) int
 	}
@@ -140,29 +150,37 @@ type ComplexityRoot struct {
 		RemoteIP   func(childComplexity int) int
 	}
 
+	ProceedsShare struct {
+		Percent   func(childComplexity int) int
+		Recipient func(childComplexity int) int
+	}
+
 	Query struct {
 		GetAccounts       func(childComplexity int, addresses []string) int
 		GetAuctionsByIds  func(childComplexity int, ids []string) int
 		GetBondsByIds     func(childComplexity int, ids []string) int
 		GetLogs           func(childComplexity int, count *int) int
+		GetOpenAuctions   func(childComplexity int, limit *int, after *string) int
 		GetRecordsByIds   func(childComplexity int, ids []string) int
 		GetStatus         func(childComplexity int) int
 		LookupAuthorities func(childComplexity int, names []string) int
 		LookupNames       func(childComplexity int, names []string) int
-		QueryBonds        func(childComplexity int, attributes []*KeyValueInput) int
-		QueryRecords      func(childComplexity int, attributes []*KeyValueInput, all *bool) int
+		QueryBonds        func(childComplexity int, attributes []*KeyValueInput, limit *int, after *string) int
+		QueryRecords      func(childComplexity int, attributes []*KeyValueInput, all *bool, limit *int, after *string) int
 		ResolveNames      func(childComplexity int, names []string) int
 	}
 
 	Record struct {
-		Attributes func(childComplexity int) int
-		BondID     func(childComplexity int) int
-		CreateTime func(childComplexity int) int
-		ExpiryTime func(childComplexity int) int
-		ID         func(childComplexity int) int
-		Names      func(childComplexity int) int
-		Owners     func(childComplexity int) int
-		References func(childComplexity int) int
+		Attributes   func(childComplexity int) int
+		AutoRenew    func(childComplexity int) int
+		BondID       func(childComplexity int) int
+		CreateTime   func(childComplexity int) int
+		ExpiryTime   func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Names        func(childComplexity int) int
+		Owners       func(childComplexity int) int
+		ReferencedBy func(childComplexity int) int
+		References   func(childComplexity int) int
 	}
 
 	RecordResult struct {
@@ -223,13 +241,17 @@ type QueryResolver interface {
 	GetLogs(ctx context.Context, count *int) ([]string, error)
 	GetAccounts(ctx context.Context, addresses []string) ([]*Account, error)
 	GetBondsByIds(ctx context.Context, ids []string) ([]*Bond, error)
-	QueryBonds(ctx context.Context, attributes []*KeyValueInput) ([]*Bond, error)
+	QueryBonds(ctx context.Context, attributes []*KeyValueInput, limit *int, after *string) ([]*Bond, error)
 	GetRecordsByIds(ctx context.Context, ids []string) ([]*Record, error)
-	QueryRecords(ctx context.Context, attributes []*KeyValueInput, all *bool) ([]*Record, error)
+	QueryRecords(ctx context.Context, attributes []*KeyValueInput, all *bool, limit *int, after *string) ([]*Record, error)
 	LookupAuthorities(ctx context.Context, names []string) (*AuthorityResult, error)
 	LookupNames(ctx context.Context, names []string) (*NameResult, error)
 	ResolveNames(ctx context.Context, names []string) (*RecordResult, error)
 	GetAuctionsByIds(ctx context.Context, ids []string) ([]*Auction, error)
+	GetOpenAuctions(ctx context.Context, limit *int, after *string) ([]*Auction, error)
+}
+type RecordResolver interface {
+	ReferencedBy(ctx context.Context, obj *Record) ([]*Record, error)
 }
 
 type executableSchema struct {
@@ -282,6 +304,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Account.Sequence(childComplexity), true
 
+	case "Auction.bidIncrement":
+		if e.complexity.Auction.BidIncrement == nil {
+			break
+		}
+
+		return e.complexity.Auction.BidIncrement(childComplexity), true
+
 	case "Auction.bids":
 		if e.complexity.Auction.Bids == nil {
 			break
@@ -310,6 +339,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Auction.CreateTime(childComplexity), true
 
+	case "Auction.extensionWindow":
+		if e.complexity.Auction.ExtensionWindow == nil {
+			break
+		}
+
+		return e.complexity.Auction.ExtensionWindow(childComplexity), true
+
 	case "Auction.id":
 		if e.complexity.Auction.ID == nil {
 			break
@@ -317,6 +353,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Auction.ID(childComplexity), true
 
+	case "Auction.kind":
+		if e.complexity.Auction.Kind == nil {
+			break
+		}
+
+		return e.complexity.Auction.Kind(childComplexity), true
+
 	case "Auction.minimumBid":
 		if e.complexity.Auction.MinimumBid == nil {
 			break
@@ -331,6 +374,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Auction.OwnerAddress(childComplexity), true
 
+	case "Auction.priceDecrement":
+		if e.complexity.Auction.PriceDecrement == nil {
+			break
+		}
+
+		return e.complexity.Auction.PriceDecrement(childComplexity), true
+
+	case "Auction.proceeds":
+		if e.complexity.Auction.Proceeds == nil {
+			break
+		}
+
+		return e.complexity.Auction.Proceeds(childComplexity), true
+
 	case "Auction.revealFee":
 		if e.complexity.Auction.RevealFee == nil {
 			break
@@ -345,6 +402,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Auction.RevealsEndTime(childComplexity), true
 
+	case "Auction.startPrice":
+		if e.complexity.Auction.StartPrice == nil {
+			break
+		}
+
+		return e.complexity.Auction.StartPrice(childComplexity), true
+
 	case "Auction.status":
 		if e.complexity.Auction.Status == nil {
 			break
@@ -471,6 +535,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AuthorityRecord.OwnerPublicKey(childComplexity), true
 
+	case "AuthorityRecord.pendingOwnerAddress":
+		if e.complexity.AuthorityRecord.PendingOwnerAddress == nil {
+			break
+		}
+
+		return e.complexity.AuthorityRecord.PendingOwnerAddress(childComplexity), true
+
 	case "AuthorityRecord.status":
 		if e.complexity.AuthorityRecord.Status == nil {
 			break
@@ -565,6 +636,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.Submit(childComplexity, args["tx"].(string)), true
 
+	case "NameRecord.aliasChain":
+		if e.complexity.NameRecord.AliasChain == nil {
+			break
+		}
+
+		return e.complexity.NameRecord.AliasChain(childComplexity), true
+
 	case "NameRecord.history":
 		if e.complexity.NameRecord.History == nil {
 			break
@@ -579,6 +657,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.NameRecord.Latest(childComplexity), true
 
+	case "NameRecordEntry.alias":
+		if e.complexity.NameRecordEntry.Alias == nil {
+			break
+		}
+
+		return e.complexity.NameRecordEntry.Alias(childComplexity), true
+
 	case "NameRecordEntry.height":
 		if e.complexity.NameRecordEntry.Height == nil {
 			break
@@ -649,6 +734,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PeerInfo.RemoteIP(childComplexity), true
 
+	case "ProceedsShare.percent":
+		if e.complexity.ProceedsShare.Percent == nil {
+			break
+		}
+
+		return e.complexity.ProceedsShare.Percent(childComplexity), true
+
+	case "ProceedsShare.recipient":
+		if e.complexity.ProceedsShare.Recipient == nil {
+			break
+		}
+
+		return e.complexity.ProceedsShare.Recipient(childComplexity), true
+
 	case "Query.getAccounts":
 		if e.complexity.Query.GetAccounts == nil {
 			break
@@ -697,6 +796,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetLogs(childComplexity, args["count"].(*int)), true
 
+	case "Query.getOpenAuctions":
+		if e.complexity.Query.GetOpenAuctions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getOpenAuctions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetOpenAuctions(childComplexity, args["limit"].(*int), args["after"].(*string)), true
+
 	case "Query.getRecordsByIds":
 		if e.complexity.Query.GetRecordsByIds == nil {
 			break
@@ -750,7 +861,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.QueryBonds(childComplexity, args["attributes"].([]*KeyValueInput)), true
+		return e.complexity.Query.QueryBonds(childComplexity, args["attributes"].([]*KeyValueInput), args["limit"].(*int), args["after"].(*string)), true
 
 	case "Query.queryRecords":
 		if e.complexity.Query.QueryRecords == nil {
@@ -762,7 +873,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.QueryRecords(childComplexity, args["attributes"].([]*KeyValueInput), args["all"].(*bool)), true
+		return e.complexity.Query.QueryRecords(childComplexity, args["attributes"].([]*KeyValueInput), args["all"].(*bool), args["limit"].(*int), args["after"].(*string)), true
 
 	case "Query.resolveNames":
 		if e.complexity.Query.ResolveNames == nil {
@@ -783,6 +894,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Record.Attributes(childComplexity), true
 
+	case "Record.autoRenew":
+		if e.complexity.Record.AutoRenew == nil {
+			break
+		}
+
+		return e.complexity.Record.AutoRenew(childComplexity), true
+
 	case "Record.bondId":
 		if e.complexity.Record.BondID == nil {
 			break
@@ -825,6 +943,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Record.Owners(childComplexity), true
 
+	case "Record.referencedBy":
+		if e.complexity.Record.ReferencedBy == nil {
+			break
+		}
+
+		return e.complexity.Record.ReferencedBy(childComplexity), true
+
 	case "Record.references":
 		if e.complexity.Record.References == nil {
 			break
@@ -1148,10 +1273,12 @@ type Record {
   bondId:     String!         # Associated bond ID.
   createTime: String!         # Record create time.
   expiryTime: String!         # Record expiry time.
+  autoRenew:  Boolean!        # Renew automatically (from the bond) on expiry.
 
   owners:     [String]!       # Addresses of record owners.
   attributes: [KeyValue]      # Record attributes.
   references: [Record]        # Record references.
+  referencedBy: [Record]      # Records referencing this record (reverse lookup).
 }
 
 # Metadata for query results, e.g. chain height, proofs.
@@ -1171,10 +1298,17 @@ type AuctionBid {
   bidAmount:      Coin!
 }
 
-# A sealed-bid, 2nd price auction.
+# Share (percentage) of the auction winner price sent to a recipient.
+type ProceedsShare {
+  recipient:      String!             # burn, owner, community_pool or an account address.
+  percent:        Int!                # Percentage of the winner price.
+}
+
+# An auction (sealed-bid, 2nd price by default).
 type Auction {
   id:             String!             # Auction ID.
-  status:         String!             # Auction status (commit, reveal, expired).
+  kind:           String!             # Auction kind (vickrey, first_price, dutch, english).
+  status:         String!             # Auction status (commit, reveal, bidding, expired, completed).
   ownerAddress:   String!             # Auction owner time.
   createTime:     String!             # Create time.
   commitsEndTime: String!             # Commit phase end time.
@@ -1182,21 +1316,27 @@ type Auction {
   commitFee:      Coin!               # Fee required to bid/participate in the auction.
   revealFee:      Coin!               # Reveal fee (paid back to bidders only if they unseal/reveal the bid).
   minimumBid:     Coin!               # Minimum bid amount.
+  startPrice:     Coin                # Dutch auction start price.
+  priceDecrement: Coin                # Dutch auction price decrement per block.
+  bidIncrement:   Coin                # English auction min. increment over the highest bid.
+  extensionWindow: String!            # English auction anti-sniping window.
   winnerAddress:  String!             # Winner address.
   winnerBid:      Coin!               # The winning bid amount.
-  winnerPrice:    Coin!               # The price that the winner actually pays (2nd highest bid).
+  winnerPrice:    Coin!               # The price that the winner actually pays (2nd highest bid for Vickrey auctions).
+  proceeds:       [ProceedsShare]     # Distribution of the winner price (empty => amount over min. bid is burnt).
   bids:           [AuctionBid]        # Bids make in the auction.
 }
 
 # Name authority record.
 type AuthorityRecord {
-  ownerAddress:     String!   # Owner address.
-  ownerPublicKey:   String!   # Owner public key.
-  height:           String!   # Height at which record was created.
-  status:           String!   # Status (active, auction, expired).
-  bondId:           String!   # Associated bond ID.
-  expiryTime:       String!   # Authority expiry time.
-  auction:          Auction   # Authority auction.
+  ownerAddress:         String!   # Owner address.
+  ownerPublicKey:       String!   # Owner public key.
+  height:               String!   # Height at which record was created.
+  status:               String!   # Status (active, auction, redemption, frozen, expired).
+  bondId:               String!   # Associated bond ID.
+  expiryTime:           String!   # Authority expiry time.
+  auction:              Auction   # Authority auction.
+  pendingOwnerAddress:  String    # New owner address, if a two-step ownership transfer is pending.
 }
 
 # Name authority result, e.g. authority record + metadata.
@@ -1213,7 +1353,8 @@ type RecordResult {
 
 # Name record entry, created at a particular height.
 type NameRecordEntry {
-  id:         String!         # Target record ID.
+  id:         String!         # Target record ID (empty for aliases).
+  alias:      String          # Target WRN, if the name is an alias.
   height:     String!         # Height at which record was created.
 }
 
@@ -1221,6 +1362,7 @@ type NameRecordEntry {
 type NameRecord {
   latest:     NameRecordEntry!     # Latest mame record entry.
   history:    [NameRecordEntry]    # Historical name record entries.
+  aliasChain: [String]             # WRNs followed when resolving the name (if it's an alias).
 }
 
 # Name lookup result.
@@ -1326,6 +1468,12 @@ type Query {
   queryBonds(
     # Multiple attribute conditions are in a logical AND.
     attributes: [KeyValueInput]
+
+    # Max. number of bonds to return (all by default).
+    limit: Int
+
+    # Cursor, i.e. ID of the last bond on the previous page.
+    after: String
   ): [Bond]
 
   #
@@ -1340,10 +1488,17 @@ type Query {
   # Query records.
   queryRecords(
     # Multiple attribute conditions are in a logical AND.
+    # Conditions on the ` + "`" + `type` + "`" + `, ` + "`" + `name` + "`" + ` or ` + "`" + `version` + "`" + ` (string) attributes are served from an index.
     attributes: [KeyValueInput]
 
     # Whether to query all records, not just named ones (false by default).
     all: Boolean
+
+    # Max. number of records to return (all by default).
+    limit: Int
+
+    # Cursor, i.e. ID of the last record on the previous page.
+    after: String
   ): [Record]
 
   #
@@ -1361,6 +1516,8 @@ type Query {
   ): NameResult!
 
   # Resolve names to records.
+  # A version (semver range) suffix, e.g. ` + "`" + `wrn://example/app@^1.2.0` + "`" + ` or ` + "`" + `wrn://example/app@latest` + "`" + `,
+  # resolves to the record with the highest matching version.
   resolveNames(
     names: [String!]
   ): RecordResult!
@@ -1373,6 +1530,15 @@ type Query {
   getAuctionsByIds(
     ids: [String!]
   ): [Auction]
+
+  # Get open auctions, i.e. in the commit or reveal phase.
+  getOpenAuctions(
+    # Max. number of auctions to return (all by default).
+    limit: Int
+
+    # Cursor, i.e. ID of the last auction on the previous page.
+    after: String
+  ): [Auction]
 }
 
 type Mutation {
@@ -1499,6 +1665,30 @@ func (ec *executionContext) field_Query_getLogs_args(ctx context.Context, rawArg
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_getOpenAuctions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_getRecordsByIds_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1556,6 +1746,24 @@ func (ec *executionContext) field_Query_queryBonds_args(ctx context.Context, raw
 		}
 	}
 	args["attributes"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg2
 	return args, nil
 }
 
@@ -1580,6 +1788,24 @@ func (ec *executionContext) field_Query_queryRecords_args(ctx context.Context, r
 		}
 	}
 	args["all"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg3
 	return args, nil
 }
 
@@ -1840,6 +2066,41 @@ func (ec *executionContext) _Auction_id(ctx context.Context, field graphql.Colle
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Auction_kind(ctx context.Context, field graphql.CollectedField, obj *Auction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Auction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Auction_status(ctx context.Context, field graphql.CollectedField, obj *Auction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -2120,6 +2381,137 @@ func (ec *executionContext) _Auction_minimumBid(ctx context.Context, field graph
 	return ec.marshalNCoin2ᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐCoin(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Auction_startPrice(ctx context.Context, field graphql.CollectedField, obj *Auction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Auction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartPrice, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Coin)
+	fc.Result = res
+	return ec.marshalOCoin2ᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐCoin(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Auction_priceDecrement(ctx context.Context, field graphql.CollectedField, obj *Auction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Auction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PriceDecrement, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Coin)
+	fc.Result = res
+	return ec.marshalOCoin2ᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐCoin(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Auction_bidIncrement(ctx context.Context, field graphql.CollectedField, obj *Auction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Auction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BidIncrement, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Coin)
+	fc.Result = res
+	return ec.marshalOCoin2ᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐCoin(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Auction_extensionWindow(ctx context.Context, field graphql.CollectedField, obj *Auction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Auction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExtensionWindow, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Auction_winnerAddress(ctx context.Context, field graphql.CollectedField, obj *Auction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -2225,6 +2617,38 @@ func (ec *executionContext) _Auction_winnerPrice(ctx context.Context, field grap
 	return ec.marshalNCoin2ᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐCoin(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Auction_proceeds(ctx context.Context, field graphql.CollectedField, obj *Auction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Auction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Proceeds, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*ProceedsShare)
+	fc.Result = res
+	return ec.marshalOProceedsShare2ᚕᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐProceedsShare(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Auction_bids(ctx context.Context, field graphql.CollectedField, obj *Auction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -2779,6 +3203,38 @@ func (ec *executionContext) _AuthorityRecord_auction(ctx context.Context, field
 	return ec.marshalOAuction2ᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐAuction(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _AuthorityRecord_pendingOwnerAddress(ctx context.Context, field graphql.CollectedField, obj *AuthorityRecord) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuthorityRecord",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PendingOwnerAddress, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _AuthorityResult_meta(ctx context.Context, field graphql.CollectedField, obj *AuthorityResult) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -3236,6 +3692,38 @@ func (ec *executionContext) _NameRecord_history(ctx context.Context, field graph
 	return ec.marshalONameRecordEntry2ᚕᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐNameRecordEntry(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _NameRecord_aliasChain(ctx context.Context, field graphql.CollectedField, obj *NameRecord) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "NameRecord",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AliasChain, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*string)
+	fc.Result = res
+	return ec.marshalOString2ᚕᚖstring(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _NameRecordEntry_id(ctx context.Context, field graphql.CollectedField, obj *NameRecordEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -3271,6 +3759,38 @@ func (ec *executionContext) _NameRecordEntry_id(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _NameRecordEntry_alias(ctx context.Context, field graphql.CollectedField, obj *NameRecordEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "NameRecordEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Alias, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _NameRecordEntry_height(ctx context.Context, field graphql.CollectedField, obj *NameRecordEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -3551,7 +4071,77 @@ func (ec *executionContext) _PeerInfo_is_outbound(ctx context.Context, field gra
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PeerInfo_remote_ip(ctx context.Context, field graphql.CollectedField, obj *PeerInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _PeerInfo_remote_ip(ctx context.Context, field graphql.CollectedField, obj *PeerInfo) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PeerInfo",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RemoteIP, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProceedsShare_recipient(ctx context.Context, field graphql.CollectedField, obj *ProceedsShare) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ProceedsShare",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Recipient, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProceedsShare_percent(ctx context.Context, field graphql.CollectedField, obj *ProceedsShare) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3559,7 +4149,7 @@ func (ec *executionContext) _PeerInfo_remote_ip(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "PeerInfo",
+		Object:     "ProceedsShare",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3569,7 +4159,7 @@ func (ec *executionContext) _PeerInfo_remote_ip(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RemoteIP, nil
+		return obj.Percent, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3581,9 +4171,9 @@ func (ec *executionContext) _PeerInfo_remote_ip(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) _Query_getStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
@@ -3763,7 +4353,7 @@ func (ec *executionContext) _Query_queryBonds(ctx context.Context, field graphql
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryBonds(rctx, args["attributes"].([]*KeyValueInput))
+		return ec.resolvers.Query().QueryBonds(rctx, args["attributes"].([]*KeyValueInput), args["limit"].(*int), args["after"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3841,7 +4431,7 @@ func (ec *executionContext) _Query_queryRecords(ctx context.Context, field graph
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryRecords(rctx, args["attributes"].([]*KeyValueInput), args["all"].(*bool))
+		return ec.resolvers.Query().QueryRecords(rctx, args["attributes"].([]*KeyValueInput), args["all"].(*bool), args["limit"].(*int), args["after"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4020,6 +4610,45 @@ func (ec *executionContext) _Query_getAuctionsByIds(ctx context.Context, field g
 	return ec.marshalOAuction2ᚕᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐAuction(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Query_getOpenAuctions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_getOpenAuctions_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetOpenAuctions(rctx, args["limit"].(*int), args["after"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Auction)
+	fc.Result = res
+	return ec.marshalOAuction2ᚕᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐAuction(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -4263,6 +4892,41 @@ func (ec *executionContext) _Record_expiryTime(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Record_autoRenew(ctx context.Context, field graphql.CollectedField, obj *Record) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Record",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AutoRenew, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Record_owners(ctx context.Context, field graphql.CollectedField, obj *Record) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -4362,6 +5026,38 @@ func (ec *executionContext) _Record_references(ctx context.Context, field graphq
 	return ec.marshalORecord2ᚕᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐRecord(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Record_referencedBy(ctx context.Context, field graphql.CollectedField, obj *Record) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Record",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Record().ReferencedBy(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Record)
+	fc.Result = res
+	return ec.marshalORecord2ᚕᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐRecord(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _RecordResult_meta(ctx context.Context, field graphql.CollectedField, obj *RecordResult) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -6542,6 +7238,11 @@ func (ec *executionContext) _Auction(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "kind":
+			out.Values[i] = ec._Auction_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		case "status":
 			out.Values[i] = ec._Auction_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -6582,6 +7283,17 @@ func (ec *executionContext) _Auction(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "startPrice":
+			out.Values[i] = ec._Auction_startPrice(ctx, field, obj)
+		case "priceDecrement":
+			out.Values[i] = ec._Auction_priceDecrement(ctx, field, obj)
+		case "bidIncrement":
+			out.Values[i] = ec._Auction_bidIncrement(ctx, field, obj)
+		case "extensionWindow":
+			out.Values[i] = ec._Auction_extensionWindow(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		case "winnerAddress":
 			out.Values[i] = ec._Auction_winnerAddress(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -6597,6 +7309,8 @@ func (ec *executionContext) _Auction(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "proceeds":
+			out.Values[i] = ec._Auction_proceeds(ctx, field, obj)
 		case "bids":
 			out.Values[i] = ec._Auction_bids(ctx, field, obj)
 		default:
@@ -6715,6 +7429,8 @@ func (ec *executionContext) _AuthorityRecord(ctx context.Context, sel ast.Select
 			}
 		case "auction":
 			out.Values[i] = ec._AuthorityRecord_auction(ctx, field, obj)
+		case "pendingOwnerAddress":
+			out.Values[i] = ec._AuthorityRecord_pendingOwnerAddress(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6904,6 +7620,8 @@ func (ec *executionContext) _NameRecord(ctx context.Context, sel ast.SelectionSe
 			}
 		case "history":
 			out.Values[i] = ec._NameRecord_history(ctx, field, obj)
+		case "aliasChain":
+			out.Values[i] = ec._NameRecord_aliasChain(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6931,6 +7649,8 @@ func (ec *executionContext) _NameRecordEntry(ctx context.Context, sel ast.Select
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "alias":
+			out.Values[i] = ec._NameRecordEntry_alias(ctx, field, obj)
 		case "height":
 			out.Values[i] = ec._NameRecordEntry_height(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -7053,6 +7773,38 @@ func (ec *executionContext) _PeerInfo(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var proceedsShareImplementors = []string{"ProceedsShare"}
+
+func (ec *executionContext) _ProceedsShare(ctx context.Context, sel ast.SelectionSet, obj *ProceedsShare) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, proceedsShareImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProceedsShare")
+		case "recipient":
+			out.Values[i] = ec._ProceedsShare_recipient(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "percent":
+			out.Values[i] = ec._ProceedsShare_percent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var queryImplementors = []string{"Query"}
 
 func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -7201,6 +7953,17 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				res = ec._Query_getAuctionsByIds(ctx, field)
 				return res
 			})
+		case "getOpenAuctions":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getOpenAuctions(ctx, field)
+				return res
+			})
 		case "__type":
 			out.Values[i] = ec._Query___type(ctx, field)
 		case "__schema":
@@ -7230,34 +7993,50 @@ func (ec *executionContext) _Record(ctx context.Context, sel ast.SelectionSet, o
 		case "id":
 			out.Values[i] = ec._Record_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "names":
 			out.Values[i] = ec._Record_names(ctx, field, obj)
 		case "bondId":
 			out.Values[i] = ec._Record_bondId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "createTime":
 			out.Values[i] = ec._Record_createTime(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "expiryTime":
 			out.Values[i] = ec._Record_expiryTime(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "autoRenew":
+			out.Values[i] = ec._Record_autoRenew(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "owners":
 			out.Values[i] = ec._Record_owners(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "attributes":
 			out.Values[i] = ec._Record_attributes(ctx, field, obj)
 		case "references":
 			out.Values[i] = ec._Record_references(ctx, field, obj)
+		case "referencedBy":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Record_referencedBy(ctx, field, obj)
+				return res
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7846,6 +8625,21 @@ func (ec *executionContext) marshalNCoin2ᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgq
 	return ec._Coin(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
 func (ec *executionContext) unmarshalNKeyValueInput2ᚕᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐKeyValueInput(ctx context.Context, v interface{}) ([]*KeyValueInput, error) {
 	var vSlice []interface{}
 	if v != nil {
@@ -8608,6 +9402,13 @@ func (ec *executionContext) marshalOCoin2ᚕᚖgithubᚗcomᚋvulcanizeᚋdxns
 	return ret
 }
 
+func (ec *executionContext) marshalOCoin2ᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐCoin(ctx context.Context, sel ast.SelectionSet, v *Coin) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Coin(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v interface{}) (*float64, error) {
 	if v == nil {
 		return nil, nil
@@ -8818,6 +9619,53 @@ func (ec *executionContext) marshalOPeerInfo2ᚖgithubᚗcomᚋvulcanizeᚋdxns
 	return ec._PeerInfo(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOProceedsShare2ᚕᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐProceedsShare(ctx context.Context, sel ast.SelectionSet, v []*ProceedsShare) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOProceedsShare2ᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐProceedsShare(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOProceedsShare2ᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐProceedsShare(ctx context.Context, sel ast.SelectionSet, v *ProceedsShare) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ProceedsShare(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalORecord2ᚕᚖgithubᚗcomᚋvulcanizeᚋdxnsᚋgqlᚐRecord(ctx context.Context, sel ast.SelectionSet, v []*Record) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -8925,6 +9773,42 @@ func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel
 	return ret
 }
 
+func (ec *executionContext) unmarshalOString2ᚕᚖstring(ctx context.Context, v interface{}) ([]*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalOString2ᚖstring(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕᚖstring(ctx context.Context, sel ast.SelectionSet, v []*string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalOString2ᚖstring(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -11,19 +11,25 @@ type Account struct {
 }
 
 type Auction struct {
-	ID             string        `json:"id"`
-	Status         string        `json:"status"`
-	OwnerAddress   string        `json:"ownerAddress"`
-	CreateTime     string        `json:"createTime"`
-	CommitsEndTime string        `json:"commitsEndTime"`
-	RevealsEndTime string        `json:"revealsEndTime"`
-	CommitFee      *Coin         `json:"commitFee"`
-	RevealFee      *Coin         `json:"revealFee"`
-	MinimumBid     *Coin         `json:"minimumBid"`
-	WinnerAddress  string        `json:"winnerAddress"`
-	WinnerBid      *Coin         `json:"winnerBid"`
-	WinnerPrice    *Coin         `json:"winnerPrice"`
-	Bids           []*AuctionBid `json:"bids"`
+	ID              string           `json:"id"`
+	Kind            string           `json:"kind"`
+	Status          string           `json:"status"`
+	OwnerAddress    string           `json:"ownerAddress"`
+	CreateTime      string           `json:"createTime"`
+	CommitsEndTime  string           `json:"commitsEndTime"`
+	RevealsEndTime  string           `json:"revealsEndTime"`
+	CommitFee       *Coin            `json:"commitFee"`
+	RevealFee       *Coin            `json:"revealFee"`
+	MinimumBid      *Coin            `json:"minimumBid"`
+	StartPrice      *Coin            `json:"startPrice"`
+	PriceDecrement  *Coin            `json:"priceDecrement"`
+	BidIncrement    *Coin            `json:"bidIncrement"`
+	ExtensionWindow string           `json:"extensionWindow"`
+	WinnerAddress   string           `json:"winnerAddress"`
+	WinnerBid       *Coin            `json:"winnerBid"`
+	WinnerPrice     *Coin            `json:"winnerPrice"`
+	Proceeds        []*ProceedsShare `json:"proceeds"`
+	Bids            []*AuctionBid    `json:"bids"`
 }
 
 type AuctionBid struct {
@@ -38,13 +44,14 @@ type AuctionBid struct {
 }
 
 type AuthorityRecord struct {
-	OwnerAddress   string   `json:"ownerAddress"`
-	OwnerPublicKey string   `json:"ownerPublicKey"`
-	Height         string   `json:"height"`
-	Status         string   `json:"status"`
-	BondID         string   `json:"bondId"`
-	ExpiryTime     string   `json:"expiryTime"`
-	Auction        *Auction `json:"auction"`
+	OwnerAddress        string   `json:"ownerAddress"`
+	OwnerPublicKey      string   `json:"ownerPublicKey"`
+	Height              string   `json:"height"`
+	Status              string   `json:"status"`
+	BondID              string   `json:"bondId"`
+	ExpiryTime          string   `json:"expiryTime"`
+	Auction             *Auction `json:"auction"`
+	PendingOwnerAddress *string  `json:"pendingOwnerAddress"`
 }
 
 type AuthorityResult struct {
@@ -74,13 +81,15 @@ type KeyValueInput struct {
 }
 
 type NameRecord struct {
-	Latest  *NameRecordEntry   `json:"latest"`
-	History []*NameRecordEntry `json:"history"`
+	Latest     *NameRecordEntry   `json:"latest"`
+	History    []*NameRecordEntry `json:"history"`
+	AliasChain []*string          `json:"aliasChain"`
 }
 
 type NameRecordEntry struct {
-	ID     string `json:"id"`
-	Height string `json:"height"`
+	ID     string  `json:"id"`
+	Alias  *string `json:"alias"`
+	Height string  `json:"height"`
 }
 
 type NameResult struct {
@@ -100,15 +109,22 @@ type PeerInfo struct {
 	RemoteIP   string    `json:"remote_ip"`
 }
 
+type ProceedsShare struct {
+	Recipient string `json:"recipient"`
+	Percent   int    `json:"percent"`
+}
+
 type Record struct {
-	ID         string      `json:"id"`
-	Names      []string    `json:"names"`
-	BondID     string      `json:"bondId"`
-	CreateTime string      `json:"createTime"`
-	ExpiryTime string      `json:"expiryTime"`
-	Owners     []*string   `json:"owners"`
-	Attributes []*KeyValue `json:"attributes"`
-	References []*Record   `json:"references"`
+	ID           string      `json:"id"`
+	Names        []string    `json:"names"`
+	BondID       string      `json:"bondId"`
+	CreateTime   string      `json:"createTime"`
+	ExpiryTime   string      `json:"expiryTime"`
+	AutoRenew    bool        `json:"autoRenew"`
+	Owners       []*string   `json:"owners"`
+	Attributes   []*KeyValue `json:"attributes"`
+	References   []*Record   `json:"references"`
+	ReferencedBy []*Record   `json:"referencedBy"`
 }
 
 type RecordResult struct {
@@ -52,6 +52,22 @@ func (r *Resolver) Query() QueryResolver {
 	return &queryResolver{r}
 }
 
+// Record is the entry point to record field resolution.
+func (r *Resolver) Record() RecordResolver {
+	return &recordResolver{r}
+}
+
+type recordResolver struct{ *Resolver }
+
+// ReferencedBy resolves the records referencing a record.
+// Resolved lazily, as resolving eagerly would recurse (through references) indefinitely.
+func (r *recordResolver) ReferencedBy(ctx context.Context, obj *Record) ([]*Record, error) {
+	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+	ids := r.keeper.GetReferencingRecordIDs(sdkContext, nameservice.ID(obj.ID))
+
+	return GetReferencingRecords(ctx, r.Query(), ids)
+}
+
 type mutationResolver struct{ *Resolver }
 
 func (r *mutationResolver) InsertRecord(ctx context.Context, attributes []*KeyValueInput) (*Record, error) {
@@ -95,12 +111,21 @@ func (r *queryResolver) GetRecordsByIds(ctx context.Context, ids []string) ([]*R
 }
 
 // QueryRecords filters records by K=V conditions.
-func (r *queryResolver) QueryRecords(ctx context.Context, attributes []*KeyValueInput, all *bool) ([]*Record, error) {
+func (r *queryResolver) QueryRecords(ctx context.Context, attributes []*KeyValueInput, all *bool, limit *int, after *string) ([]*Record, error) {
 	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+	pagination := GetPagination(limit, after)
 
-	var records = r.keeper.MatchRecords(sdkContext, func(record *nameservice.Record) bool {
+	matchFn := func(record *nameservice.Record) bool {
 		return MatchOnAttributes(record, attributes, (all != nil && *all))
-	})
+	}
+
+	// Use a secondary index, if possible, to avoid scanning all records.
+	var records []*nameservice.Record
+	if key, value, found := GetIndexedAttribute(attributes); found {
+		records = r.keeper.MatchIndexedRecords(sdkContext, key, value, matchFn, pagination)
+	} else {
+		records = r.keeper.MatchRecords(sdkContext, matchFn, pagination)
+	}
 
 	return QueryRecords(ctx, r, records, attributes)
 }
@@ -151,7 +176,10 @@ func (r *queryResolver) LookupAuthorities(ctx context.Context, names []string) (
 	gqlResponse := []*AuthorityRecord{}
 
 	for _, name := range names {
-		record := r.keeper.GetNameAuthority(sdkContext, name)
+		var record *nameservice.NameAuthority
+		if normalizedName, err := nameservice.NormalizeName(name); err == nil {
+			record = r.keeper.GetNameAuthority(sdkContext, normalizedName)
+		}
 
 		gqlRecord, err := GetGQLNameAuthorityRecord(ctx, r, record)
 		if err != nil {
@@ -194,6 +222,12 @@ func (r *queryResolver) LookupNames(ctx context.Context, names []string) (*NameR
 			return nil, err
 		}
 
+		if record != nil && record.Alias != "" {
+			if chain, err := r.keeper.GetAliasChain(sdkContext, name); err == nil {
+				gqlRecord.AliasChain = GetGQLAliasChain(chain)
+			}
+		}
+
 		gqlResponse = append(gqlResponse, gqlRecord)
 	}
 
@@ -358,13 +392,13 @@ func (r *queryResolver) GetBond(ctx context.Context, id string) (*Bond, error) {
 	return nil, nil
 }
 
-func (r *queryResolver) QueryBonds(ctx context.Context, attributes []*KeyValueInput) ([]*Bond, error) {
+func (r *queryResolver) QueryBonds(ctx context.Context, attributes []*KeyValueInput, limit *int, after *string) ([]*Bond, error) {
 	sdkContext := r.baseApp.NewContext(true, abci.Header{})
 	gqlResponse := []*Bond{}
 
 	var bonds = r.bondKeeper.MatchBonds(sdkContext, func(bondObj *bond.Bond) bool {
 		return matchBondOnAttributes(bondObj, attributes)
-	})
+	}, GetPagination(limit, after))
 
 	for _, bondObj := range bonds {
 		gqlBond, err := getGQLBond(ctx, r, bondObj)
@@ -395,3 +429,20 @@ func (r *queryResolver) GetAuctionsByIds(ctx context.Context, ids []string) ([]*
 
 	return gqlResponse, nil
 }
+
+func (r *queryResolver) GetOpenAuctions(ctx context.Context, limit *int, after *string) ([]*Auction, error) {
+	sdkContext := r.baseApp.NewContext(true, abci.Header{})
+	gqlResponse := []*Auction{}
+
+	for _, auctionObj := range r.auctionKeeper.ListOpenAuctions(sdkContext, GetPagination(limit, after)) {
+		bids := r.auctionKeeper.GetBids(sdkContext, auctionObj.ID)
+		gqlAuction, err := GetGQLAuction(ctx, r, auctionObj, bids)
+		if err != nil {
+			return nil, err
+		}
+
+		gqlResponse = append(gqlResponse, gqlAuction)
+	}
+
+	return gqlResponse, nil
+}
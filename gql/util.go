@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"strconv"
 
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/auction"
 	"github.com/vulcanize/dxns/x/bond"
 	"github.com/vulcanize/dxns/x/nameservice"
@@ -48,6 +49,7 @@ func GetGQLRecord(ctx context.Context, resolver QueryResolver, record *nameservi
 		BondID:     record.GetBondID(),
 		CreateTime: record.GetCreateTime(),
 		ExpiryTime: record.GetExpiryTime(),
+		AutoRenew:  !record.DisableAutoRenew,
 		Owners:     record.GetOwners(),
 		Attributes: attributes,
 		References: references,
@@ -71,12 +73,28 @@ func GetGQLNameRecord(ctx context.Context, resolver QueryResolver, record *names
 }
 
 func getNameRecordEntry(record nameservice.NameRecordEntry) *NameRecordEntry {
+	var alias *string
+	if record.Alias != "" {
+		alias = &record.Alias
+	}
+
 	return &NameRecordEntry{
 		ID:     string(record.ID),
+		Alias:  alias,
 		Height: strconv.FormatInt(record.Height, 10),
 	}
 }
 
+// GetGQLAliasChain converts the WRNs followed when resolving an alias.
+func GetGQLAliasChain(chain []string) []*string {
+	gqlChain := make([]*string, len(chain))
+	for index := range chain {
+		gqlChain[index] = &chain[index]
+	}
+
+	return gqlChain
+}
+
 func GetGQLNameAuthorityRecord(ctx context.Context, resolver QueryResolver, record *nameservice.NameAuthority) (*AuthorityRecord, error) {
 	if record == nil {
 		return nil, nil
@@ -89,6 +107,8 @@ func GetGQLNameAuthorityRecord(ctx context.Context, resolver QueryResolver, reco
 		Status:         string(record.Status),
 		BondID:         record.GetBondID(),
 		ExpiryTime:     record.GetExpiryTime(),
+
+		PendingOwnerAddress: getPendingOwnerAddress(record),
 	}, nil
 }
 
@@ -105,24 +125,42 @@ func getAuctionBid(bid *auction.Bid) *AuctionBid {
 	}
 }
 
+func getGQLProceeds(policy auction.ProceedsPolicy) []*ProceedsShare {
+	shares := make([]*ProceedsShare, len(policy))
+	for index, share := range policy {
+		shares[index] = &ProceedsShare{
+			Recipient: share.Recipient,
+			Percent:   int(share.Percent),
+		}
+	}
+
+	return shares
+}
+
 func GetGQLAuction(ctx context.Context, resolver QueryResolver, auction *auction.Auction, bids []*auction.Bid) (*Auction, error) {
 	if auction == nil {
 		return nil, nil
 	}
 
 	gqlAuction := Auction{
-		ID:             string(auction.ID),
-		Status:         auction.Status,
-		OwnerAddress:   auction.OwnerAddress,
-		CreateTime:     auction.GetCreateTime(),
-		CommitsEndTime: auction.GetCommitsEndTime(),
-		RevealsEndTime: auction.GetRevealsEndTime(),
-		CommitFee:      getGQLCoin(auction.CommitFee),
-		RevealFee:      getGQLCoin(auction.RevealFee),
-		MinimumBid:     getGQLCoin(auction.MinimumBid),
-		WinnerAddress:  auction.WinnerAddress,
-		WinnerBid:      getGQLCoin(auction.WinnerBid),
-		WinnerPrice:    getGQLCoin(auction.WinnerPrice),
+		ID:              string(auction.ID),
+		Kind:            auction.GetKind(),
+		Status:          auction.Status,
+		OwnerAddress:    auction.OwnerAddress,
+		CreateTime:      auction.GetCreateTime(),
+		CommitsEndTime:  auction.GetCommitsEndTime(),
+		RevealsEndTime:  auction.GetRevealsEndTime(),
+		CommitFee:       getGQLCoin(auction.CommitFee),
+		RevealFee:       getGQLCoin(auction.RevealFee),
+		MinimumBid:      getGQLCoin(auction.MinimumBid),
+		StartPrice:      getGQLCoin(auction.StartPrice),
+		PriceDecrement:  getGQLCoin(auction.PriceDecrement),
+		BidIncrement:    getGQLCoin(auction.BidIncrement),
+		ExtensionWindow: auction.ExtensionWindow.String(),
+		WinnerAddress:   auction.WinnerAddress,
+		WinnerBid:       getGQLCoin(auction.WinnerBid),
+		WinnerPrice:     getGQLCoin(auction.WinnerPrice),
+		Proceeds:        getGQLProceeds(auction.Proceeds),
 	}
 
 	auctionBids := make([]*AuctionBid, len(bids))
@@ -154,6 +192,29 @@ func getReferences(ctx context.Context, resolver QueryResolver, r *nameservice.R
 	return resolver.GetRecordsByIds(ctx, ids)
 }
 
+// GetReferencingRecords gets the (live) records for the given referencing record IDs.
+func GetReferencingRecords(ctx context.Context, resolver QueryResolver, ids []nameservice.ID) ([]*Record, error) {
+	recordIDs := make([]string, len(ids))
+	for index, id := range ids {
+		recordIDs[index] = string(id)
+	}
+
+	records, err := resolver.GetRecordsByIds(ctx, recordIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleted/expired records don't count as dependents.
+	liveRecords := []*Record{}
+	for _, record := range records {
+		if record != nil {
+			liveRecords = append(liveRecords, record)
+		}
+	}
+
+	return liveRecords, nil
+}
+
 func getAttributes(r *nameservice.Record) ([]*KeyValue, error) {
 	return mapToKeyValuePairs(r.Attributes)
 }
@@ -248,6 +309,32 @@ func matchOnRecordField(record *nameservice.Record, attr *KeyValueInput) (fieldF
 	return
 }
 
+// GetIndexedAttribute returns the first (string) attribute filter that can be served by a secondary index.
+func GetIndexedAttribute(attributes []*KeyValueInput) (key string, value string, found bool) {
+	for _, attr := range attributes {
+		if attr.Value.String != nil && nameservice.IsIndexedAttribute(attr.Key) {
+			return attr.Key, *attr.Value.String, true
+		}
+	}
+
+	return "", "", false
+}
+
+// GetPagination returns the pagination params for the (optional) limit and cursor query args.
+func GetPagination(limit *int, after *string) wnsUtils.Pagination {
+	var pagination wnsUtils.Pagination
+
+	if limit != nil {
+		pagination.Limit = *limit
+	}
+
+	if after != nil {
+		pagination.After = *after
+	}
+
+	return pagination
+}
+
 func MatchOnAttributes(record *nameservice.Record, attributes []*KeyValueInput, all bool) bool {
 	// Filter deleted records.
 	if record.Deleted {
@@ -383,3 +470,11 @@ func matchBondOnAttributes(bondObj *bond.Bond, attributes []*KeyValueInput) bool
 
 	return true
 }
+
+func getPendingOwnerAddress(record *nameservice.NameAuthority) *string {
+	if record.PendingOwnerAddress == "" {
+		return nil
+	}
+
+	return &record.PendingOwnerAddress
+}
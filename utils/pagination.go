@@ -0,0 +1,74 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// Pagination selects a page of results for list queries.
+// The cursor (After) is the store key, without the index prefix, of the last result on the previous page.
+// i.e. the ID of the last record/bond/auction, or the last WRN for name records.
+type Pagination struct {
+	// Max. number of results to return (0 => no limit).
+	Limit int `json:"limit,omitempty"`
+
+	// Return results after this cursor (empty => from the start).
+	After string `json:"after,omitempty"`
+}
+
+// IsFull returns true if a page with count results is full.
+func (pagination Pagination) IsFull(count int) bool {
+	return pagination.Limit > 0 && count >= pagination.Limit
+}
+
+// PaginatedIterator returns an iterator over the prefix, starting after the pagination cursor.
+func PaginatedIterator(store sdk.KVStore, prefix []byte, pagination Pagination) sdk.Iterator {
+	if pagination.After == "" {
+		return sdk.KVStorePrefixIterator(store, prefix)
+	}
+
+	// Smallest key that sorts after the cursor key.
+	start := append(append(append([]byte{}, prefix...), []byte(pagination.After)...), 0x00)
+
+	return store.Iterator(start, sdk.PrefixEndBytes(prefix))
+}
+
+// ParsePagination parses pagination params from query request data.
+func ParsePagination(data []byte) (Pagination, error) {
+	var pagination Pagination
+	if len(data) == 0 {
+		return pagination, nil
+	}
+
+	err := json.Unmarshal(data, &pagination)
+
+	return pagination, err
+}
+
+// AddPaginationFlags adds the pagination flags to a list query command.
+func AddPaginationFlags(cmd *cobra.Command, cursorName string) {
+	cmd.Flags().Int("limit", 0, "Max. number of results to return (0 => no limit).")
+	cmd.Flags().String("after", "", fmt.Sprintf("Return results after this %s (i.e. the last one on the previous page).", cursorName))
+}
+
+// GetPaginationFromFlags returns the (JSON encoded) pagination params from the command flags.
+func GetPaginationFromFlags(cmd *cobra.Command) ([]byte, error) {
+	limit, err := cmd.Flags().GetInt("limit")
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := cmd.Flags().GetString("after")
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(Pagination{Limit: limit, After: after})
+}
@@ -16,10 +16,20 @@ const (
 
 	AuctionBurnModuleAccountName = types.AuctionBurnModuleAccountName
 	AuctionStatusCompleted       = types.AuctionStatusCompleted
+
+	AuctionKindVickrey    = types.AuctionKindVickrey
+	AuctionKindFirstPrice = types.AuctionKindFirstPrice
+	AuctionKindDutch      = types.AuctionKindDutch
+	AuctionKindEnglish    = types.AuctionKindEnglish
+
+	ProceedsRecipientBurn          = types.ProceedsRecipientBurn
+	ProceedsRecipientOwner         = types.ProceedsRecipientOwner
+	ProceedsRecipientCommunityPool = types.ProceedsRecipientCommunityPool
 )
 
 var (
 	DefaultParamspace = types.DefaultParamspace
+	DefaultParams     = types.DefaultParams
 	NewKeeper         = keeper.NewKeeper
 	NewQuerier        = keeper.NewQuerier
 	ModuleCdc         = types.ModuleCdc
@@ -28,6 +38,7 @@ var (
 	RegisterInvariants = keeper.RegisterInvariants
 
 	NewMsgCreateAuction = types.NewMsgCreateAuction
+	ParseProceedsPolicy = types.ParseProceedsPolicy
 
 	PrefixIDToAuctionIndex     = keeper.PrefixIDToAuctionIndex
 	PrefixAuctionBidsIndex     = keeper.PrefixAuctionBidsIndex
@@ -36,8 +47,9 @@ var (
 	GetBidIndexKey             = keeper.GetBidIndexKey
 	GetOwnerToAuctionsIndexKey = keeper.GetOwnerToAuctionsIndexKey
 
-	GetAuction = keeper.GetAuction
-	GetBids    = keeper.GetBids
+	GetAuction    = keeper.GetAuction
+	GetBids       = keeper.GetBids
+	MatchAuctions = keeper.MatchAuctions
 )
 
 type (
@@ -52,4 +64,6 @@ type (
 	AuctionUsageKeeper  = types.AuctionUsageKeeper
 	AuctionClientKeeper = keeper.AuctionClientKeeper
 	Params              = types.Params
+	ProceedsShare       = types.ProceedsShare
+	ProceedsPolicy      = types.ProceedsPolicy
 )
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/auction/internal/types"
 )
 
@@ -41,7 +42,7 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 // GetCmdList queries all auctions.
 func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List auctions.",
 		Args:  cobra.ExactArgs(0),
@@ -50,7 +51,12 @@ func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list", queryRoute), nil)
+			pagination, err := wnsUtils.GetPaginationFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list", queryRoute), pagination)
 			if err != nil {
 				return err
 			}
@@ -60,6 +66,10 @@ func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+
+	wnsUtils.AddPaginationFlags(cmd, "auction ID")
+
+	return cmd
 }
 
 // GetCmdGetBid queries an auction bid.
@@ -33,15 +33,124 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	// TODO(ashwin): Add Tx commands.
 	auctionTxCmd.AddCommand(flags.PostCommands(
+		GetCmdCreateAuction(cdc),
 		GetCmdCommitBid(cdc),
 		GetCmdRevealBid(cdc),
+		GetCmdPlaceBid(cdc),
 	)...)
 
 	return auctionTxCmd
 }
 
+// GetCmdCreateAuction is the CLI command for creating an auction.
+func GetCmdCreateAuction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create auction.",
+		Long: `Create an auction.
+
+Auction kinds:
+  vickrey     : Sealed-bid, 2nd price auction (default).
+  first_price : Sealed-bid, 1st price auction.
+  dutch       : Open auction, the price starts at --start-price and decays by --price-decrement every block,
+                down to --minimum-bid. The first bid at or over the current price wins.
+  english     : Open, ascending auction. Bids within --extension-window before the end extend the auction.
+
+Open auctions accept bids (place-bid) for --commits-duration.
+By default, the winner price is paid to the auction creator (see --proceeds).`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			commitFee, err := sdk.ParseCoin(viper.GetString("commit-fee"))
+			if err != nil {
+				return err
+			}
+
+			revealFee, err := sdk.ParseCoin(viper.GetString("reveal-fee"))
+			if err != nil {
+				return err
+			}
+
+			minimumBid, err := sdk.ParseCoin(viper.GetString("minimum-bid"))
+			if err != nil {
+				return err
+			}
+
+			proceeds, err := types.ParseProceedsPolicy(viper.GetString("proceeds"))
+			if err != nil {
+				return err
+			}
+
+			kind := viper.GetString("kind")
+			if !types.IsValidAuctionKind(kind) {
+				return fmt.Errorf("invalid auction kind: %s", kind)
+			}
+
+			// Open auctions don't have a reveal phase.
+			revealsDuration := viper.GetDuration("reveals-duration")
+			if !types.IsSealedBidAuctionKind(kind) {
+				revealsDuration = 0
+			}
+
+			params := types.Params{
+				CommitsDuration: viper.GetDuration("commits-duration"),
+				RevealsDuration: revealsDuration,
+				CommitFee:       commitFee,
+				RevealFee:       revealFee,
+				MinimumBid:      minimumBid,
+				Proceeds:        proceeds,
+			}
+
+			msg := types.NewMsgCreateAuction(params, cliCtx.GetFromAddress())
+			msg.Kind = kind
+			msg.ExtensionWindow = viper.GetDuration("extension-window")
+
+			switch kind {
+			case types.AuctionKindDutch:
+				if msg.StartPrice, err = sdk.ParseCoin(viper.GetString("start-price")); err != nil {
+					return err
+				}
+
+				if msg.PriceDecrement, err = sdk.ParseCoin(viper.GetString("price-decrement")); err != nil {
+					return err
+				}
+			case types.AuctionKindEnglish:
+				msg.BidIncrement = sdk.NewCoin(minimumBid.Denom, sdk.ZeroInt())
+				if bidIncrement := viper.GetString("bid-increment"); bidIncrement != "" {
+					if msg.BidIncrement, err = sdk.ParseCoin(bidIncrement); err != nil {
+						return err
+					}
+				}
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	defaultParams := types.DefaultParams()
+	cmd.Flags().Duration("commits-duration", defaultParams.CommitsDuration, "Duration of the commits phase.")
+	cmd.Flags().Duration("reveals-duration", defaultParams.RevealsDuration, "Duration of the reveals phase.")
+	cmd.Flags().String("commit-fee", defaultParams.CommitFee.String(), "Fee for committing a bid (e.g. 1000000uwire).")
+	cmd.Flags().String("reveal-fee", defaultParams.RevealFee.String(), "Fee for revealing a bid, returned on reveal (e.g. 1000000uwire).")
+	cmd.Flags().String("minimum-bid", defaultParams.MinimumBid.String(), "Minimum bid (e.g. 5000000uwire).")
+	cmd.Flags().String("kind", types.AuctionKindVickrey, "Auction kind (vickrey, first_price, dutch or english).")
+	cmd.Flags().String("start-price", "", "Dutch auction start price (e.g. 50000000uwire).")
+	cmd.Flags().String("price-decrement", "", "Dutch auction price decrement per block (e.g. 10000uwire).")
+	cmd.Flags().String("bid-increment", "", "English auction min. increment over the highest bid (e.g. 100000uwire).")
+	cmd.Flags().Duration("extension-window", 0, "English auction anti-sniping window (e.g. 10m).")
+	cmd.Flags().String("proceeds", types.ProceedsRecipientOwner, "Distribution of the winner price (e.g. owner:80,community_pool:20 or burn).")
+
+	return cmd
+}
+
 // GetCmdCommitBid is the CLI command for committing a bid.
 func GetCmdCommitBid(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -129,3 +238,32 @@ func GetCmdRevealBid(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdPlaceBid is the CLI command for placing an open bid.
+func GetCmdPlaceBid(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-bid [auction-id] [bid-amount]",
+		Short: "Place open bid (Dutch and English auctions).",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			bidAmount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBid(args[0], bidAmount, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
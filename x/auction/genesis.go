@@ -7,6 +7,7 @@ package auction
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/auction/internal/types"
 )
 
@@ -20,7 +21,7 @@ func NewGenesisState(params types.Params, auctions []types.Auction) GenesisState
 }
 
 func ValidateGenesis(data GenesisState) error {
-	err := data.Params.Validate()
+	err := data.Params.WithDefaults().Validate()
 	if err != nil {
 		return err
 	}
@@ -33,7 +34,7 @@ func DefaultGenesisState() GenesisState {
 }
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.ValidatorUpdate {
-	keeper.SetParams(ctx, data.Params)
+	keeper.SetParams(ctx, data.Params.WithDefaults())
 
 	for _, auction := range data.Auctions {
 		keeper.SaveAuction(ctx, auction)
@@ -44,7 +45,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	params := keeper.GetParams(ctx)
-	auctions := keeper.ListAuctions(ctx)
+	auctions := keeper.ListAuctions(ctx, wnsUtils.Pagination{})
 
 	return GenesisState{Params: params, Auctions: auctions}
 }
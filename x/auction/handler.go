@@ -23,6 +23,8 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgCommitBid(ctx, keeper, msg)
 		case types.MsgRevealBid:
 			return handleMsgRevealBid(ctx, keeper, msg)
+		case types.MsgPlaceBid:
+			return handleMsgPlaceBid(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -68,3 +70,16 @@ func handleMsgRevealBid(ctx sdk.Context, keeper Keeper, msg types.MsgRevealBid)
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+// Handle MsgPlaceBid.
+func handleMsgPlaceBid(ctx sdk.Context, keeper Keeper, msg types.MsgPlaceBid) (*sdk.Result, error) {
+	auction, err := keeper.PlaceBid(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(auction.ID),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
@@ -0,0 +1,123 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	wnsUtils "github.com/vulcanize/dxns/utils"
+	"github.com/vulcanize/dxns/x/auction/internal/types"
+)
+
+// testInput holds the keepers and context used by the keeper tests.
+type testInput struct {
+	ctx           sdk.Context
+	cdc           *codec.Codec
+	paramsKeeper  params.Keeper
+	accountKeeper auth.AccountKeeper
+	bankKeeper    bank.Keeper
+	supplyKeeper  supply.Keeper
+	keeper        Keeper
+}
+
+func makeTestCodec() *codec.Codec {
+	cdc := codec.New()
+
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	return cdc
+}
+
+func createTestInput(t *testing.T) testInput {
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyAuth := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyAuction := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	for _, key := range []sdk.StoreKey{keyParams, keyAuth, keySupply, keyAuction} {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := makeTestCodec()
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain", Time: time.Now().UTC(), Height: 1}, false, log.NewNopLogger())
+
+	maccPerms := map[string][]string{
+		types.ModuleName:                   nil,
+		types.AuctionBurnModuleAccountName: nil,
+	}
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAuth, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), map[string]bool{})
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
+	keeper := NewKeeper(accountKeeper, bankKeeper, supplyKeeper, nil, keyAuction, cdc, paramsKeeper.Subspace(types.DefaultParamspace))
+
+	accountKeeper.SetParams(ctx, auth.DefaultParams())
+	bankKeeper.SetSendEnabled(ctx, true)
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	return testInput{
+		ctx:           ctx,
+		cdc:           cdc,
+		paramsKeeper:  paramsKeeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		supplyKeeper:  supplyKeeper,
+		keeper:        keeper,
+	}
+}
+
+// createTestAccount creates a funded account.
+func (input testInput) createTestAccount(t *testing.T, coins string) sdk.AccAddress {
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	input.accountKeeper.SetAccount(input.ctx, input.accountKeeper.NewAccountWithAddress(input.ctx, address))
+
+	amount, err := sdk.ParseCoins(coins)
+	require.NoError(t, err)
+	require.NoError(t, input.bankKeeper.SetCoins(input.ctx, address, amount))
+
+	return address
+}
+
+// commitTestBid commits a bid and returns the reveal (hex encoded).
+func (input testInput) commitTestBid(t *testing.T, auction *types.Auction, bidder sdk.AccAddress, amount string) string {
+	commitHash, content, err := wnsUtils.GenerateHash(map[string]interface{}{
+		"chainId":       input.ctx.ChainID(),
+		"auctionId":     string(auction.ID),
+		"bidderAddress": bidder.String(),
+		"bidAmount":     amount,
+		"noise":         bidder.String(),
+	})
+	require.NoError(t, err)
+
+	_, err = input.keeper.CommitBid(input.ctx, types.NewMsgCommitBid(string(auction.ID), commitHash, bidder))
+	require.NoError(t, err)
+
+	return hex.EncodeToString(content)
+}
@@ -42,6 +42,7 @@ type Keeper struct {
 	accountKeeper auth.AccountKeeper
 	bankKeeper    bank.Keeper
 	supplyKeeper  supply.Keeper
+	distrKeeper   types.DistrKeeper
 
 	// Track auction usage in other cosmos-sdk modules (more like a usage tracker).
 	usageKeepers []types.AuctionUsageKeeper
@@ -61,12 +62,13 @@ type AuctionClientKeeper interface {
 }
 
 // NewKeeper creates new instances of the auction Keeper
-func NewKeeper(accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper, supplyKeeper supply.Keeper,
+func NewKeeper(accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper, supplyKeeper supply.Keeper, distrKeeper types.DistrKeeper,
 	storeKey sdk.StoreKey, cdc *codec.Codec, paramstore params.Subspace) Keeper {
 	return Keeper{
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		supplyKeeper:  supplyKeeper,
+		distrKeeper:   distrKeeper,
 		storeKey:      storeKey,
 		cdc:           cdc,
 		paramSubspace: paramstore.WithKeyTable(types.ParamKeyTable()),
@@ -96,7 +98,7 @@ func GetBidIndexKey(auctionID types.ID, bidder string) []byte {
 }
 
 func GetAuctionBidsIndexPrefix(auctionID types.ID) []byte {
-	return append(append(PrefixAuctionBidsIndex, []byte(auctionID)...))
+	return append(PrefixAuctionBidsIndex, []byte(auctionID)...)
 }
 
 // SaveAuction - saves a auction to the store.
@@ -205,14 +207,14 @@ func (k Keeper) GetBid(ctx sdk.Context, id types.ID, bidder string) types.Bid {
 	return obj
 }
 
-// ListAuctions - get all auctions.
-func (k Keeper) ListAuctions(ctx sdk.Context) []types.Auction {
+// ListAuctions - get all auctions (page).
+func (k Keeper) ListAuctions(ctx sdk.Context, pagination wnsUtils.Pagination) []types.Auction {
 	var auctions []types.Auction
 
 	store := ctx.KVStore(k.storeKey)
-	itr := sdk.KVStorePrefixIterator(store, PrefixIDToAuctionIndex)
+	itr := wnsUtils.PaginatedIterator(store, PrefixIDToAuctionIndex, pagination)
 	defer itr.Close()
-	for ; itr.Valid(); itr.Next() {
+	for ; itr.Valid() && !pagination.IsFull(len(auctions)); itr.Next() {
 		bz := store.Get(itr.Key())
 		if bz != nil {
 			var obj types.Auction
@@ -224,6 +226,33 @@ func (k Keeper) ListAuctions(ctx sdk.Context) []types.Auction {
 	return auctions
 }
 
+// ListOpenAuctions - get auctions in the commit or reveal phase (page).
+func (k Keeper) ListOpenAuctions(ctx sdk.Context, pagination wnsUtils.Pagination) []*types.Auction {
+	return MatchAuctions(ctx.KVStore(k.storeKey), k.cdc, func(auction *types.Auction) bool {
+		return auction.IsOpen()
+	}, pagination)
+}
+
+// MatchAuctions - get all matching auctions (page).
+func MatchAuctions(store sdk.KVStore, codec *amino.Codec, matchFn func(*types.Auction) bool, pagination wnsUtils.Pagination) []*types.Auction {
+	var auctions []*types.Auction
+
+	itr := wnsUtils.PaginatedIterator(store, PrefixIDToAuctionIndex, pagination)
+	defer itr.Close()
+	for ; itr.Valid() && !pagination.IsFull(len(auctions)); itr.Next() {
+		bz := store.Get(itr.Key())
+		if bz != nil {
+			var obj types.Auction
+			codec.MustUnmarshalBinaryBare(bz, &obj)
+			if matchFn(&obj) {
+				auctions = append(auctions, &obj)
+			}
+		}
+	}
+
+	return auctions
+}
+
 // QueryAuctionsByOwner - query auctions by owner.
 func (k Keeper) QueryAuctionsByOwner(ctx sdk.Context, ownerAddress string) []types.Auction {
 	var auctions []types.Auction
@@ -247,33 +276,60 @@ func (k Keeper) QueryAuctionsByOwner(ctx sdk.Context, ownerAddress string) []typ
 
 // MatchAuctions - get all matching auctions.
 func (k Keeper) MatchAuctions(ctx sdk.Context, matchFn func(*types.Auction) bool) []*types.Auction {
-	var auctions []*types.Auction
+	return MatchAuctions(ctx.KVStore(k.storeKey), k.cdc, matchFn, wnsUtils.Pagination{})
+}
 
-	store := ctx.KVStore(k.storeKey)
-	itr := sdk.KVStorePrefixIterator(store, PrefixIDToAuctionIndex)
-	defer itr.Close()
-	for ; itr.Valid(); itr.Next() {
-		bz := store.Get(itr.Key())
-		if bz != nil {
-			var obj types.Auction
-			k.cdc.MustUnmarshalBinaryBare(bz, &obj)
-			if matchFn(&obj) {
-				auctions = append(auctions, &obj)
-			}
-		}
+// CreateAuction creates a new auction, owned by the signer.
+func (k Keeper) CreateAuction(ctx sdk.Context, msg types.MsgCreateAuction) (*types.Auction, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
 	}
 
-	return auctions
+	params := k.GetParams(ctx)
+	if msg.CommitsDuration > params.MaxCommitsDuration {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Commits phase duration exceeds max. duration.")
+	}
+
+	if msg.RevealsDuration > params.MaxRevealsDuration {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Reveals phase duration exceeds max. duration.")
+	}
+
+	if err := params.CheckCommitFee(msg.CommitFee); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Auctions created without a proceeds policy use the default policy, or else pay the owner.
+	proceeds := msg.Proceeds
+	if len(proceeds) == 0 {
+		proceeds = params.Proceeds
+	}
+
+	if len(proceeds) == 0 {
+		proceeds = types.ProceedsPolicy{{Recipient: types.ProceedsRecipientOwner, Percent: 100}}
+	}
+
+	return k.createAuction(ctx, msg, msg.Signer, proceeds)
 }
 
-// CreateAuction creates a new auction.
-func (k Keeper) CreateAuction(ctx sdk.Context, msg types.MsgCreateAuction) (*types.Auction, error) {
-	// Might be called from another module directly, always validate.
+// CreateModuleAuction creates a new auction on behalf of another module, owned by its module account.
+// Module auctions are configured by the module (params), so the auction param limits don't apply.
+// The proceeds policy is used as is (empty => burn anything over the min. bid amount).
+func (k Keeper) CreateModuleAuction(ctx sdk.Context, moduleName string, msg types.MsgCreateAuction) (*types.Auction, error) {
+	// Called from another module directly, always validate.
 	err := msg.ValidateBasic()
 	if err != nil {
 		return nil, err
 	}
 
+	return k.createAuction(ctx, msg, k.supplyKeeper.GetModuleAddress(moduleName), msg.Proceeds)
+}
+
+func (k Keeper) createAuction(ctx sdk.Context, msg types.MsgCreateAuction, owner sdk.AccAddress, proceeds types.ProceedsPolicy) (*types.Auction, error) {
+	if owner.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid auction owner.")
+	}
+
 	// Generate auction ID.
 	account := k.accountKeeper.GetAccount(ctx, msg.Signer)
 	if account == nil {
@@ -288,19 +344,35 @@ func (k Keeper) CreateAuction(ctx sdk.Context, msg types.MsgCreateAuction) (*typ
 
 	// Compute timestamps.
 	now := ctx.BlockTime()
+	status := types.AuctionStatusCommitPhase
 	commitsEndTime := now.Add(msg.CommitsDuration)
 	revealsEndTime := now.Add(msg.CommitsDuration + msg.RevealsDuration)
 
+	// Open auctions accept bids until the commits end time, there's no reveal phase.
+	if !types.IsSealedBidAuctionKind(msg.Kind) {
+		status = types.AuctionStatusBiddingPhase
+		revealsEndTime = commitsEndTime
+	}
+
 	auction := types.Auction{
 		ID:             types.ID(auctionID),
-		Status:         types.AuctionStatusCommitPhase,
-		OwnerAddress:   msg.Signer.String(),
+		Status:         status,
+		Kind:           types.GetAuctionKind(msg.Kind),
+		OwnerAddress:   owner.String(),
 		CreateTime:     now,
 		CommitsEndTime: commitsEndTime,
 		RevealsEndTime: revealsEndTime,
 		CommitFee:      msg.CommitFee,
 		RevealFee:      msg.RevealFee,
 		MinimumBid:     msg.MinimumBid,
+		CreateHeight:   ctx.BlockHeight(),
+
+		StartPrice:      msg.StartPrice,
+		PriceDecrement:  msg.PriceDecrement,
+		BidIncrement:    msg.BidIncrement,
+		ExtensionWindow: msg.ExtensionWindow,
+
+		Proceeds: proceeds,
 	}
 
 	// Save auction in store.
@@ -442,6 +514,111 @@ func (k Keeper) RevealBid(ctx sdk.Context, msg types.MsgRevealBid) (*types.Aucti
 	return auction, nil
 }
 
+// PlaceBid places an open bid for a Dutch or English auction.
+func (k Keeper) PlaceBid(ctx sdk.Context, msg types.MsgPlaceBid) (*types.Auction, error) {
+	if !k.HasAuction(ctx, msg.AuctionID) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction not found.")
+	}
+
+	auction := k.GetAuction(ctx, msg.AuctionID)
+	if auction.Status != types.AuctionStatusBiddingPhase {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction is not in bidding phase.")
+	}
+
+	if msg.BidAmount.Denom != auction.MinimumBid.Denom {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid bid denomination.")
+	}
+
+	bidder := msg.Signer.String()
+	bidAmount := msg.BidAmount
+
+	switch auction.GetKind() {
+	case types.AuctionKindDutch:
+		// First bid at or over the current price wins, at the current price.
+		price := auction.GetDutchPrice(ctx.BlockHeight())
+		if bidAmount.IsLT(price) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Bid is lower than current price.")
+		}
+
+		bidAmount = price
+	case types.AuctionKindEnglish:
+		if bidAmount.IsLT(auction.MinimumBid) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Bid is lower than minimum bid.")
+		}
+
+		highestBid := k.getHighestOpenBid(ctx, auction.ID)
+		if highestBid != nil {
+			if highestBid.BidderAddress == bidder {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Bidder already has the highest bid.")
+			}
+
+			minBid := highestBid.BidAmount.Add(auction.BidIncrement)
+			if bidAmount.IsLT(minBid) || !highestBid.BidAmount.IsLT(bidAmount) {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Bid is lower than highest bid plus increment.")
+			}
+		}
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction doesn't accept open bids.")
+	}
+
+	// Take commit fee and lock bid amount.
+	sdkErr := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Signer, types.ModuleName, sdk.NewCoins(auction.CommitFee).Add(bidAmount))
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	// Return the bid amount locked by an earlier (outbid) bid.
+	if k.HasBid(ctx, auction.ID, bidder) {
+		oldBid := k.GetBid(ctx, auction.ID, bidder)
+		sdkErr := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, msg.Signer, sdk.NewCoins(oldBid.BidAmount))
+		if sdkErr != nil {
+			return nil, sdkErr
+		}
+	}
+
+	now := ctx.BlockTime()
+	bid := types.Bid{
+		AuctionID:     auction.ID,
+		BidderAddress: bidder,
+		Status:        types.BidStatusRevealed,
+		CommitTime:    now,
+		CommitFee:     auction.CommitFee,
+		RevealTime:    now,
+		RevealFee:     sdk.NewCoin(auction.RevealFee.Denom, sdk.ZeroInt()),
+		BidAmount:     bidAmount,
+	}
+
+	k.SaveBid(ctx, bid)
+
+	switch auction.GetKind() {
+	case types.AuctionKindDutch:
+		// Winner is picked in the end blocker.
+		auction.Status = types.AuctionStatusExpired
+		k.SaveAuction(ctx, *auction)
+	case types.AuctionKindEnglish:
+		// Anti-sniping, extend the auction if the bid is close to the end.
+		if auction.ExtensionWindow > 0 && auction.CommitsEndTime.Sub(now) < auction.ExtensionWindow {
+			auction.CommitsEndTime = now.Add(auction.ExtensionWindow)
+			auction.RevealsEndTime = auction.CommitsEndTime
+			k.SaveAuction(ctx, *auction)
+		}
+	}
+
+	return auction, nil
+}
+
+// getHighestOpenBid returns the highest bid of an open auction.
+func (k Keeper) getHighestOpenBid(ctx sdk.Context, id types.ID) *types.Bid {
+	var highestBid *types.Bid
+	for _, bid := range k.GetBids(ctx, id) {
+		if highestBid == nil || highestBid.BidAmount.IsLT(bid.BidAmount) {
+			highestBid = bid
+		}
+	}
+
+	return highestBid
+}
+
 // GetAuctionModuleBalances gets the auction module account(s) balances.
 func (k Keeper) GetAuctionModuleBalances(ctx sdk.Context) map[string]sdk.Coins {
 	balances := map[string]sdk.Coins{}
@@ -479,6 +656,13 @@ func (k Keeper) processAuctionPhases(ctx sdk.Context) {
 			ctx.Logger().Info(fmt.Sprintf("Moved auction %s to reveal phase.", auction.ID))
 		}
 
+		// Bidding -> Expired state (open auctions).
+		if auction.Status == types.AuctionStatusBiddingPhase && ctx.BlockTime().After(auction.CommitsEndTime) {
+			auction.Status = types.AuctionStatusExpired
+			k.SaveAuction(ctx, *auction)
+			ctx.Logger().Info(fmt.Sprintf("Moved auction %s to expired state.", auction.ID))
+		}
+
 		// Reveal -> Expired state.
 		if auction.Status == types.AuctionStatusRevealPhase && ctx.BlockTime().After(auction.RevealsEndTime) {
 			auction.Status = types.AuctionStatusExpired
@@ -551,16 +735,16 @@ func (k Keeper) pickAuctionWinner(ctx sdk.Context, auction *types.Auction) {
 		}
 	}
 
-	// Highest bid is the winner, but pays second highest bid price.
+	// Highest bid is the winner, but pays second highest bid price in Vickrey auctions.
 	auction.Status = types.AuctionStatusCompleted
 
 	if highestBid != nil {
 		auction.WinnerAddress = highestBid.BidderAddress
 		auction.WinnerBid = highestBid.BidAmount
+		auction.WinnerPrice = highestBid.BidAmount
 
 		// Winner pays 2nd price, if a 2nd price exists.
-		auction.WinnerPrice = highestBid.BidAmount
-		if secondHighestBid != nil {
+		if auction.GetKind() == types.AuctionKindVickrey && secondHighestBid != nil {
 			auction.WinnerPrice = secondHighestBid.BidAmount
 		}
 
@@ -590,45 +774,86 @@ func (k Keeper) pickAuctionWinner(ctx sdk.Context, auction *types.Auction) {
 		}
 
 		// Send back locked bid amount to all bidders.
-		sdkErr := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidderAddress, sdk.NewCoins(bid.BidAmount))
+		// The winner price is paid from the winner's locked bid amount, as the winner's
+		// account balance might no longer cover it. The rest of the winning bid is returned.
+		refundAmount := bid.BidAmount
+		if bid.Status == types.BidStatusRevealed && bid.BidderAddress == auction.WinnerAddress {
+			refundAmount = bid.BidAmount.Sub(auction.WinnerPrice)
+		}
+
+		sdkErr := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidderAddress, sdk.NewCoins(refundAmount))
 		if sdkErr != nil {
 			ctx.Logger().Error(fmt.Sprintf("Auction error returning bid amount: %v", sdkErr))
 			panic(sdkErr)
 		}
 	}
 
-	// Process winner account (if nobody bids, there won't be a winner).
+	// Process winner price (if nobody bids, there won't be a winner).
 	if auction.WinnerAddress != "" {
-		winnerAddress, err := sdk.AccAddressFromBech32(auction.WinnerAddress)
-		if err != nil {
-			panic("Invalid winner address.")
-		}
+		k.distributeAuctionProceeds(ctx, auction)
+	}
 
-		// Take 2nd price from winner.
-		sdkErr := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, winnerAddress, types.ModuleName, sdk.NewCoins(auction.WinnerPrice))
-		if sdkErr != nil {
-			ctx.Logger().Error(fmt.Sprintf("Auction error taking funds from winner: %v", sdkErr))
-			panic(sdkErr)
+	// Notify other modules (hook).
+	ctx.Logger().Info(fmt.Sprintf("Auction %s notifying %d modules.", auction.ID, len(k.usageKeepers)))
+	for _, keeper := range k.usageKeepers {
+		ctx.Logger().Info(fmt.Sprintf("Auction %s notifying module %s.", auction.ID, keeper.ModuleName()))
+		keeper.OnAuctionWinnerSelected(ctx, auction.ID)
+	}
+}
+
+// Distribute the winner price according to the auction proceeds policy.
+func (k Keeper) distributeAuctionProceeds(ctx sdk.Context, auction *types.Auction) {
+	if len(auction.Proceeds) == 0 {
+		k.burnAuctionProceeds(ctx, auction)
+		return
+	}
+
+	amounts := auction.Proceeds.Split(auction.WinnerPrice)
+	for index, share := range auction.Proceeds {
+		amount := sdk.NewCoins(amounts[index])
+		if amount.Empty() {
+			continue
 		}
 
-		// Burn anything over the min. bid amount.
-		amountToBurn := auction.WinnerPrice.Sub(auction.MinimumBid)
-		if amountToBurn.IsNegative() {
-			panic("Auction coins to burn cannot be negative.")
+		var sdkErr error
+		switch share.Recipient {
+		case types.ProceedsRecipientBurn:
+			// Use auction burn module account instead of actually burning coins to better keep track of supply.
+			sdkErr = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.AuctionBurnModuleAccountName, amount)
+		case types.ProceedsRecipientCommunityPool:
+			sdkErr = k.distrKeeper.FundCommunityPool(ctx, amount, k.supplyKeeper.GetModuleAddress(types.ModuleName))
+		default:
+			recipient := share.Recipient
+			if recipient == types.ProceedsRecipientOwner {
+				recipient = auction.OwnerAddress
+			}
+
+			recipientAddress, err := sdk.AccAddressFromBech32(recipient)
+			if err != nil {
+				panic("Invalid auction proceeds recipient address.")
+			}
+
+			sdkErr = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddress, amount)
 		}
 
-		// Use auction burn module account instead of actually burning coins to better keep track of supply.
-		sdkErr = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.AuctionBurnModuleAccountName, sdk.NewCoins(amountToBurn))
 		if sdkErr != nil {
-			ctx.Logger().Error(fmt.Sprintf("Auction error burning coins: %v", sdkErr))
+			ctx.Logger().Error(fmt.Sprintf("Auction error distributing proceeds to %s: %v", share.Recipient, sdkErr))
 			panic(sdkErr)
 		}
 	}
+}
 
-	// Notify other modules (hook).
-	ctx.Logger().Info(fmt.Sprintf("Auction %s notifying %d modules.", auction.ID, len(k.usageKeepers)))
-	for _, keeper := range k.usageKeepers {
-		ctx.Logger().Info(fmt.Sprintf("Auction %s notifying module %s.", auction.ID, keeper.ModuleName()))
-		keeper.OnAuctionWinnerSelected(ctx, auction.ID)
+// Burn anything over the min. bid amount.
+func (k Keeper) burnAuctionProceeds(ctx sdk.Context, auction *types.Auction) {
+	amountToBurn := auction.WinnerPrice.Sub(auction.MinimumBid)
+	if amountToBurn.IsNegative() {
+		panic("Auction coins to burn cannot be negative.")
+	}
+
+	// Use auction burn module account instead of actually burning coins to better keep track of supply.
+	sdkErr := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.AuctionBurnModuleAccountName, sdk.NewCoins(amountToBurn))
+	if sdkErr != nil {
+		ctx.Logger().Error(fmt.Sprintf("Auction error burning coins: %v", sdkErr))
+		panic(sdkErr)
 	}
 }
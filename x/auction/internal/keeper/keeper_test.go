@@ -0,0 +1,220 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/vulcanize/dxns/x/auction/internal/types"
+)
+
+// Winner spends their balance after revealing, the winner price is paid from the locked bid amount.
+func TestPickAuctionWinnerWithDrainedWinnerBalance(t *testing.T) {
+	input := createTestInput(t)
+	owner := input.createTestAccount(t, "1000000000uwire")
+	winner := input.createTestAccount(t, "1000000000uwire")
+	bidder := input.createTestAccount(t, "1000000000uwire")
+
+	auction, err := input.keeper.CreateAuction(input.ctx, types.NewMsgCreateAuction(types.DefaultParams(), owner))
+	require.NoError(t, err)
+
+	winnerReveal := input.commitTestBid(t, auction, winner, "20000000uwire")
+	bidderReveal := input.commitTestBid(t, auction, bidder, "10000000uwire")
+
+	// Commit -> Reveal phase.
+	input.ctx = input.ctx.WithBlockTime(auction.CommitsEndTime.Add(time.Second))
+	input.keeper.EndBlockerProcessAuctions(input.ctx)
+
+	_, err = input.keeper.RevealBid(input.ctx, types.NewMsgRevealBid(string(auction.ID), winnerReveal, winner))
+	require.NoError(t, err)
+	_, err = input.keeper.RevealBid(input.ctx, types.NewMsgRevealBid(string(auction.ID), bidderReveal, bidder))
+	require.NoError(t, err)
+
+	// Winner drains their balance.
+	require.NoError(t, input.bankKeeper.SendCoins(input.ctx, winner, owner, input.bankKeeper.GetCoins(input.ctx, winner)))
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, winner).IsZero())
+	ownerBalance := input.bankKeeper.GetCoins(input.ctx, owner)
+
+	// Reveal -> Expired -> Completed.
+	input.ctx = input.ctx.WithBlockTime(auction.RevealsEndTime.Add(time.Second))
+	require.NotPanics(t, func() {
+		input.keeper.EndBlockerProcessAuctions(input.ctx)
+	})
+
+	completed := input.keeper.GetAuction(input.ctx, auction.ID)
+	require.Equal(t, types.AuctionStatusCompleted, completed.Status)
+	require.Equal(t, winner.String(), completed.WinnerAddress)
+	require.Equal(t, sdk.NewInt64Coin("uwire", 10000000), completed.WinnerPrice)
+
+	// Winner gets back the reveal fee and the locked bid amount, less the winner price.
+	params := types.DefaultParams()
+	expectedRefund := sdk.NewCoins(params.RevealFee).Add(sdk.NewInt64Coin("uwire", 20000000-10000000))
+	require.Equal(t, expectedRefund, input.bankKeeper.GetCoins(input.ctx, winner))
+
+	// The winner price is paid to the owner.
+	require.Equal(t, ownerBalance.Add(completed.WinnerPrice), input.bankKeeper.GetCoins(input.ctx, owner))
+}
+
+func TestCreateAuctionDefaultProceeds(t *testing.T) {
+	input := createTestInput(t)
+	owner := input.createTestAccount(t, "1000000000uwire")
+
+	// Standalone auctions without a proceeds policy pay the owner.
+	standalone, err := input.keeper.CreateAuction(input.ctx, types.NewMsgCreateAuction(types.DefaultParams(), owner))
+	require.NoError(t, err)
+	require.Equal(t, owner.String(), standalone.OwnerAddress)
+	require.Equal(t, types.ProceedsPolicy{{Recipient: types.ProceedsRecipientOwner, Percent: 100}}, standalone.Proceeds)
+
+	// Module auctions are owned by the module account and keep the policy as is.
+	module, err := input.keeper.CreateModuleAuction(input.ctx, types.ModuleName, types.NewMsgCreateAuction(types.DefaultParams(), owner))
+	require.NoError(t, err)
+	require.Equal(t, input.supplyKeeper.GetModuleAddress(types.ModuleName).String(), module.OwnerAddress)
+	require.Empty(t, module.Proceeds)
+}
+
+func TestCreateModuleAuctionSkipsLimits(t *testing.T) {
+	input := createTestInput(t)
+	owner := input.createTestAccount(t, "1000000000uwire")
+
+	params := types.DefaultParams()
+	params.CommitsDuration = params.MaxCommitsDuration + time.Hour
+	params.CommitFee = sdk.NewInt64Coin("uwire", 1)
+
+	_, err := input.keeper.CreateAuction(input.ctx, types.NewMsgCreateAuction(params, owner))
+	require.Error(t, err)
+
+	_, err = input.keeper.CreateModuleAuction(input.ctx, types.ModuleName, types.NewMsgCreateAuction(params, owner))
+	require.NoError(t, err)
+}
+
+func TestMigrateParams(t *testing.T) {
+	input := createTestInput(t)
+
+	// Chain started before the auction params were added.
+	keeper := NewKeeper(input.accountKeeper, input.bankKeeper, input.supplyKeeper, nil, input.keeper.storeKey, input.cdc, input.paramsKeeper.Subspace("legacy"))
+	require.Panics(t, func() { keeper.GetParams(input.ctx) })
+
+	keeper.MigrateParams(input.ctx)
+	require.True(t, types.DefaultParams().Equal(keeper.GetParams(input.ctx)))
+
+	// Params already set are kept.
+	params := types.DefaultParams()
+	params.CommitsDuration = time.Hour
+	keeper.SetParams(input.ctx, params)
+	keeper.MigrateParams(input.ctx)
+	require.True(t, params.Equal(keeper.GetParams(input.ctx)))
+
+	// Genesis files exported before the params were added.
+	require.NoError(t, types.Params{}.WithDefaults().Validate())
+	require.True(t, types.DefaultParams().Equal(types.Params{}.WithDefaults()))
+}
+
+func TestDutchAuction(t *testing.T) {
+	input := createTestInput(t)
+	owner := input.createTestAccount(t, "1000000000uwire")
+	bidder := input.createTestAccount(t, "1000000000uwire")
+	otherBidder := input.createTestAccount(t, "1000000000uwire")
+
+	msg := types.NewMsgCreateAuction(types.DefaultParams(), owner)
+	msg.Kind = types.AuctionKindDutch
+	msg.MinimumBid = sdk.NewInt64Coin("uwire", 10000000)
+	msg.StartPrice = sdk.NewInt64Coin("uwire", 100000000)
+	msg.PriceDecrement = sdk.NewInt64Coin("uwire", 10000000)
+	auction, err := input.keeper.CreateAuction(input.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, types.AuctionStatusBiddingPhase, auction.Status)
+
+	// Price decays every block, but not below the minimum bid.
+	require.Equal(t, msg.StartPrice, auction.GetDutchPrice(auction.CreateHeight))
+	require.Equal(t, sdk.NewInt64Coin("uwire", 50000000), auction.GetDutchPrice(auction.CreateHeight+5))
+	require.Equal(t, msg.MinimumBid, auction.GetDutchPrice(auction.CreateHeight+9))
+	require.Equal(t, msg.MinimumBid, auction.GetDutchPrice(auction.CreateHeight+100))
+
+	ctx := input.ctx.WithBlockHeight(auction.CreateHeight + 5)
+	_, err = input.keeper.PlaceBid(ctx, types.NewMsgPlaceBid(string(auction.ID), sdk.NewInt64Coin("uwire", 40000000), bidder))
+	require.Error(t, err, "bid under the current price")
+
+	// First bid at or over the current price wins, at the current price.
+	_, err = input.keeper.PlaceBid(ctx, types.NewMsgPlaceBid(string(auction.ID), sdk.NewInt64Coin("uwire", 60000000), bidder))
+	require.NoError(t, err)
+	require.Equal(t, types.AuctionStatusExpired, input.keeper.GetAuction(ctx, auction.ID).Status)
+
+	_, err = input.keeper.PlaceBid(ctx, types.NewMsgPlaceBid(string(auction.ID), sdk.NewInt64Coin("uwire", 100000000), otherBidder))
+	require.Error(t, err, "bid after the auction ended")
+
+	input.keeper.EndBlockerProcessAuctions(ctx)
+
+	auction = input.keeper.GetAuction(ctx, auction.ID)
+	require.Equal(t, types.AuctionStatusCompleted, auction.Status)
+	require.Equal(t, bidder.String(), auction.WinnerAddress)
+	require.Equal(t, sdk.NewInt64Coin("uwire", 50000000), auction.WinnerPrice)
+
+	// Winner pays the commit fee and the price at the time of the bid.
+	require.Equal(t, int64(1000000000-1000000-50000000), input.bankKeeper.GetCoins(ctx, bidder).AmountOf("uwire").Int64())
+	require.Equal(t, int64(1000000000+50000000), input.bankKeeper.GetCoins(ctx, owner).AmountOf("uwire").Int64())
+}
+
+func TestEnglishAuction(t *testing.T) {
+	input := createTestInput(t)
+	owner := input.createTestAccount(t, "1000000000uwire")
+	bidder := input.createTestAccount(t, "1000000000uwire")
+	otherBidder := input.createTestAccount(t, "1000000000uwire")
+
+	msg := types.NewMsgCreateAuction(types.DefaultParams(), owner)
+	msg.Kind = types.AuctionKindEnglish
+	msg.CommitsDuration = time.Hour
+	msg.BidIncrement = sdk.NewInt64Coin("uwire", 1000000)
+	msg.ExtensionWindow = time.Minute * 10
+	auction, err := input.keeper.CreateAuction(input.ctx, msg)
+	require.NoError(t, err)
+
+	ctx := input.ctx
+	_, err = input.keeper.PlaceBid(ctx, types.NewMsgPlaceBid(string(auction.ID), sdk.NewInt64Coin("uwire", 1000000), bidder))
+	require.Error(t, err, "bid under the minimum bid")
+
+	_, err = input.keeper.PlaceBid(ctx, types.NewMsgPlaceBid(string(auction.ID), sdk.NewInt64Coin("uwire", 10000000), bidder))
+	require.NoError(t, err)
+
+	_, err = input.keeper.PlaceBid(ctx, types.NewMsgPlaceBid(string(auction.ID), sdk.NewInt64Coin("uwire", 10500000), otherBidder))
+	require.Error(t, err, "bid under the highest bid plus increment")
+
+	_, err = input.keeper.PlaceBid(ctx, types.NewMsgPlaceBid(string(auction.ID), sdk.NewInt64Coin("uwire", 11000000), otherBidder))
+	require.NoError(t, err)
+
+	// Bids outside the extension window don't extend the auction.
+	require.Equal(t, auction.CommitsEndTime, input.keeper.GetAuction(ctx, auction.ID).CommitsEndTime)
+
+	// Bid close to the end extends the auction by the extension window.
+	ctx = ctx.WithBlockTime(auction.CommitsEndTime.Add(-time.Minute))
+	_, err = input.keeper.PlaceBid(ctx, types.NewMsgPlaceBid(string(auction.ID), sdk.NewInt64Coin("uwire", 20000000), bidder))
+	require.NoError(t, err)
+
+	extended := input.keeper.GetAuction(ctx, auction.ID)
+	require.Equal(t, ctx.BlockTime().Add(msg.ExtensionWindow), extended.CommitsEndTime)
+	require.Equal(t, extended.CommitsEndTime, extended.RevealsEndTime)
+
+	// Auction doesn't end at the original end time.
+	ctx = ctx.WithBlockTime(auction.CommitsEndTime.Add(time.Second))
+	input.keeper.EndBlockerProcessAuctions(ctx)
+	require.Equal(t, types.AuctionStatusBiddingPhase, input.keeper.GetAuction(ctx, auction.ID).Status)
+
+	ctx = ctx.WithBlockTime(extended.CommitsEndTime.Add(time.Second))
+	input.keeper.EndBlockerProcessAuctions(ctx)
+
+	completed := input.keeper.GetAuction(ctx, auction.ID)
+	require.Equal(t, types.AuctionStatusCompleted, completed.Status)
+	require.Equal(t, bidder.String(), completed.WinnerAddress)
+	require.Equal(t, sdk.NewInt64Coin("uwire", 20000000), completed.WinnerPrice)
+
+	// Outbid bidders only lose the commit fees, the winner also pays the highest bid.
+	require.Equal(t, int64(1000000000-1000000), input.bankKeeper.GetCoins(ctx, otherBidder).AmountOf("uwire").Int64())
+	require.Equal(t, int64(1000000000-2*1000000-20000000), input.bankKeeper.GetCoins(ctx, bidder).AmountOf("uwire").Int64())
+
+	// Locked bid amounts are all paid out, only the commit fees are kept.
+	require.Equal(t, int64(3*1000000), input.keeper.GetAuctionModuleBalances(ctx)[types.ModuleName].AmountOf("uwire").Int64())
+}
@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/auction/internal/types"
 )
 
@@ -52,7 +53,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 
 // nolint: unparam
 func listAuctions(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
-	auctions := keeper.ListAuctions(ctx)
+	pagination, err := wnsUtils.ParsePagination(req.Data)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid pagination params.")
+	}
+
+	auctions := keeper.ListAuctions(ctx, pagination)
 
 	bz, err2 := json.MarshalIndent(auctions, "", "  ")
 	if err2 != nil {
@@ -22,4 +22,5 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateAuction{}, "auction/CreateAuction", nil)
 	cdc.RegisterConcrete(MsgCommitBid{}, "auction/CommitBid", nil)
 	cdc.RegisterConcrete(MsgRevealBid{}, "auction/RevealBid", nil)
+	cdc.RegisterConcrete(MsgPlaceBid{}, "auction/PlaceBid", nil)
 }
@@ -18,3 +18,8 @@ type AuctionUsageKeeper interface {
 	OnAuctionBid(ctx sdk.Context, auctionID ID, bidderAddress string)
 	OnAuctionWinnerSelected(ctx sdk.Context, auctionID ID)
 }
+
+// DistrKeeper is the subset of the distribution keeper used to fund the community pool.
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
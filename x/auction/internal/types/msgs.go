@@ -16,11 +16,17 @@ const RouterKey = ModuleName // this was defined in your key.go file
 
 // MsgCreateAuction defines a create auction message.
 type MsgCreateAuction struct {
+	Kind            string         `json:"kind,omitempty"`
 	CommitsDuration time.Duration  `json:"commitsDuration,omitempty"`
 	RevealsDuration time.Duration  `json:"revealsDuration,omitempty"`
 	CommitFee       sdk.Coin       `json:"commitFee,omitempty"`
 	RevealFee       sdk.Coin       `json:"revealFee,omitempty"`
 	MinimumBid      sdk.Coin       `json:"minimumBid,omitempty"`
+	Proceeds        ProceedsPolicy `json:"proceeds,omitempty"`
+	StartPrice      sdk.Coin       `json:"startPrice,omitempty"`
+	PriceDecrement  sdk.Coin       `json:"priceDecrement,omitempty"`
+	BidIncrement    sdk.Coin       `json:"bidIncrement,omitempty"`
+	ExtensionWindow time.Duration  `json:"extensionWindow,omitempty"`
 	Signer          sdk.AccAddress `json:"signer"`
 }
 
@@ -32,6 +38,7 @@ func NewMsgCreateAuction(params Params, signer sdk.AccAddress) MsgCreateAuction
 		CommitFee:       params.CommitFee,
 		RevealFee:       params.RevealFee,
 		MinimumBid:      params.MinimumBid,
+		Proceeds:        params.Proceeds,
 		Signer:          signer,
 	}
 }
@@ -48,18 +55,50 @@ func (msg MsgCreateAuction) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer.String())
 	}
 
+	if !IsValidAuctionKind(msg.Kind) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid auction kind.")
+	}
+
 	if msg.CommitsDuration <= 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commit phase duration invalid.")
 	}
 
-	if msg.RevealsDuration <= 0 {
+	// Open auctions don't have a reveal phase.
+	if IsSealedBidAuctionKind(msg.Kind) && msg.RevealsDuration <= 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "reveal phase duration invalid.")
 	}
 
-	if !msg.MinimumBid.IsPositive() {
+	if !msg.CommitFee.IsValid() || !msg.RevealFee.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid commit/reveal fee.")
+	}
+
+	if !msg.MinimumBid.IsValid() || !msg.MinimumBid.IsPositive() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "minimum bid should be greater than zero.")
 	}
 
+	if err := msg.Proceeds.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	switch GetAuctionKind(msg.Kind) {
+	case AuctionKindDutch:
+		if !msg.StartPrice.IsValid() || msg.StartPrice.Denom != msg.MinimumBid.Denom || msg.StartPrice.IsLT(msg.MinimumBid) {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "start price should be at least the minimum bid.")
+		}
+
+		if !msg.PriceDecrement.IsValid() || msg.PriceDecrement.Denom != msg.MinimumBid.Denom || !msg.PriceDecrement.IsPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "price decrement should be greater than zero.")
+		}
+	case AuctionKindEnglish:
+		if !msg.BidIncrement.IsValid() || msg.BidIncrement.Denom != msg.MinimumBid.Denom {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bid increment.")
+		}
+
+		if msg.ExtensionWindow < 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "extension window invalid.")
+		}
+	}
+
 	return nil
 }
 
@@ -172,3 +211,53 @@ func (msg MsgRevealBid) GetSignBytes() []byte {
 func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
+
+// MsgPlaceBid defines an open bid message (Dutch and English auctions).
+type MsgPlaceBid struct {
+	AuctionID ID             `json:"auctionId,omitempty"`
+	BidAmount sdk.Coin       `json:"bidAmount,omitempty"`
+	Signer    sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgPlaceBid is the constructor function for MsgPlaceBid.
+func NewMsgPlaceBid(auctionID string, bidAmount sdk.Coin, signer sdk.AccAddress) MsgPlaceBid {
+
+	return MsgPlaceBid{
+		AuctionID: ID(auctionID),
+		BidAmount: bidAmount,
+		Signer:    signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgPlaceBid) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgPlaceBid) Type() string { return "bid" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgPlaceBid) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer.String())
+	}
+
+	if msg.AuctionID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid auction ID.")
+	}
+
+	if !msg.BidAmount.IsValid() || !msg.BidAmount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bid amount should be greater than zero.")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgPlaceBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
@@ -6,10 +6,12 @@ package types
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 )
 
@@ -18,36 +20,106 @@ const (
 	DefaultParamspace = ModuleName
 )
 
+// Default parameter values.
+const (
+	DefaultCommitsDuration time.Duration = time.Hour * 24
+	DefaultRevealsDuration time.Duration = time.Hour * 24
+	DefaultCommitFee       int64         = 1000000
+	DefaultRevealFee       int64         = 1000000
+	DefaultMinimumBid      int64         = 5000000
+
+	DefaultMaxCommitsDuration time.Duration = time.Hour * 24 * 7
+	DefaultMaxRevealsDuration time.Duration = time.Hour * 24 * 7
+	DefaultMinCommitFee       int64         = 100000
+
+	// DefaultDenom is the denomination of the default fees and bids.
+	DefaultDenom string = "uwire"
+)
+
+// Keys for parameter access
+var (
+	KeyCommitsDuration = []byte("CommitsDuration")
+	KeyRevealsDuration = []byte("RevealsDuration")
+	KeyCommitFee       = []byte("CommitFee")
+	KeyRevealFee       = []byte("RevealFee")
+	KeyMinimumBid      = []byte("MinimumBid")
+
+	KeyMaxCommitsDuration = []byte("MaxCommitsDuration")
+	KeyMaxRevealsDuration = []byte("MaxRevealsDuration")
+	KeyMinCommitFee       = []byte("MinCommitFee")
+
+	KeyProceeds = []byte("Proceeds")
+)
+
 var _ subspace.ParamSet = (*Params)(nil)
 
 // Params defines the parameters for the auction module.
+// The first set of params are the defaults for new auctions, the rest are limits enforced when creating auctions.
 type Params struct {
 	// Duration of commits phase in seconds.
-	CommitsDuration time.Duration `json:"commits_duration"`
+	CommitsDuration time.Duration `json:"commits_duration" yaml:"commits_duration"`
 
 	// Duration of reveals phase in seconds.
-	RevealsDuration time.Duration `json:"reveals_duration"`
+	RevealsDuration time.Duration `json:"reveals_duration" yaml:"reveals_duration"`
 
 	// Commit and reveal fees.
-	CommitFee sdk.Coin `json:"commit_fee"`
-	RevealFee sdk.Coin `json:"reveal_fee"`
+	CommitFee sdk.Coin `json:"commit_fee" yaml:"commit_fee"`
+	RevealFee sdk.Coin `json:"reveal_fee" yaml:"reveal_fee"`
 
-	MinimumBid sdk.Coin `json:"minimum_bid"`
+	MinimumBid sdk.Coin `json:"minimum_bid" yaml:"minimum_bid"`
+
+	// Max. duration of the commits and reveals phases.
+	MaxCommitsDuration time.Duration `json:"max_commits_duration" yaml:"max_commits_duration"`
+	MaxRevealsDuration time.Duration `json:"max_reveals_duration" yaml:"max_reveals_duration"`
+
+	// Min. commit fee (zero => any fee is allowed).
+	MinCommitFee sdk.Coin `json:"min_commit_fee" yaml:"min_commit_fee"`
+
+	// Default distribution of the winner price, for auctions created without a proceeds policy (empty => owner).
+	Proceeds ProceedsPolicy `json:"proceeds" yaml:"proceeds"`
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(commitsDuration time.Duration, revealsDuration time.Duration,
+	commitFee sdk.Coin, revealFee sdk.Coin, minimumBid sdk.Coin,
+	maxCommitsDuration time.Duration, maxRevealsDuration time.Duration, minCommitFee sdk.Coin,
+	proceeds ProceedsPolicy) Params {
+
+	return Params{
+		CommitsDuration: commitsDuration,
+		RevealsDuration: revealsDuration,
+		CommitFee:       commitFee,
+		RevealFee:       revealFee,
+		MinimumBid:      minimumBid,
+
+		MaxCommitsDuration: maxCommitsDuration,
+		MaxRevealsDuration: maxRevealsDuration,
+		MinCommitFee:       minCommitFee,
+
+		Proceeds: proceeds,
+	}
 }
 
-// ParamKeyTable - ParamTable for bond module.
+// ParamKeyTable - ParamTable for auction module.
 func ParamKeyTable() subspace.KeyTable {
 	return subspace.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // ParamSetPairs - implements params.ParamSet
 func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
-	return subspace.ParamSetPairs{}
+	return subspace.ParamSetPairs{
+		params.NewParamSetPair(KeyCommitsDuration, &p.CommitsDuration, validateCommitsDuration),
+		params.NewParamSetPair(KeyRevealsDuration, &p.RevealsDuration, validateRevealsDuration),
+		params.NewParamSetPair(KeyCommitFee, &p.CommitFee, validateCommitFee),
+		params.NewParamSetPair(KeyRevealFee, &p.RevealFee, validateRevealFee),
+		params.NewParamSetPair(KeyMinimumBid, &p.MinimumBid, validateMinimumBid),
+
+		params.NewParamSetPair(KeyMaxCommitsDuration, &p.MaxCommitsDuration, validateMaxCommitsDuration),
+		params.NewParamSetPair(KeyMaxRevealsDuration, &p.MaxRevealsDuration, validateMaxRevealsDuration),
+		params.NewParamSetPair(KeyMinCommitFee, &p.MinCommitFee, validateMinCommitFee),
+
+		params.NewParamSetPair(KeyProceeds, &p.Proceeds, validateProceeds),
+	}
 }
 
 // Equal returns a boolean determining if two Params types are identical.
@@ -59,17 +131,196 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return Params{}
+	return NewParams(DefaultCommitsDuration, DefaultRevealsDuration,
+		sdk.NewInt64Coin(DefaultDenom, DefaultCommitFee), sdk.NewInt64Coin(DefaultDenom, DefaultRevealFee),
+		sdk.NewInt64Coin(DefaultDenom, DefaultMinimumBid),
+		DefaultMaxCommitsDuration, DefaultMaxRevealsDuration, sdk.NewInt64Coin(DefaultDenom, DefaultMinCommitFee),
+		ProceedsPolicy{},
+	)
 }
 
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	var sb strings.Builder
 	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("Commits Duration     : %v\n", p.CommitsDuration))
+	sb.WriteString(fmt.Sprintf("Reveals Duration     : %v\n", p.RevealsDuration))
+	sb.WriteString(fmt.Sprintf("Commit Fee           : %v\n", p.CommitFee))
+	sb.WriteString(fmt.Sprintf("Reveal Fee           : %v\n", p.RevealFee))
+	sb.WriteString(fmt.Sprintf("Minimum Bid          : %v\n", p.MinimumBid))
+	sb.WriteString(fmt.Sprintf("Max Commits Duration : %v\n", p.MaxCommitsDuration))
+	sb.WriteString(fmt.Sprintf("Max Reveals Duration : %v\n", p.MaxRevealsDuration))
+	sb.WriteString(fmt.Sprintf("Min Commit Fee       : %v\n", p.MinCommitFee))
+	sb.WriteString(fmt.Sprintf("Proceeds             : %v\n", p.Proceeds))
 	return sb.String()
 }
 
+func validateDuration(name string, i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", name, i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("%s must be a positive duration", name)
+	}
+
+	return nil
+}
+
+func validateCoin(name string, i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", name, i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("%s invalid amount: %s", name, v)
+	}
+
+	return nil
+}
+
+func validateCommitsDuration(i interface{}) error {
+	return validateDuration("CommitsDuration", i)
+}
+
+func validateRevealsDuration(i interface{}) error {
+	return validateDuration("RevealsDuration", i)
+}
+
+func validateCommitFee(i interface{}) error {
+	return validateCoin("CommitFee", i)
+}
+
+func validateRevealFee(i interface{}) error {
+	return validateCoin("RevealFee", i)
+}
+
+func validateMinimumBid(i interface{}) error {
+	return validateCoin("MinimumBid", i)
+}
+
+func validateMaxCommitsDuration(i interface{}) error {
+	return validateDuration("MaxCommitsDuration", i)
+}
+
+func validateMaxRevealsDuration(i interface{}) error {
+	return validateDuration("MaxRevealsDuration", i)
+}
+
+func validateMinCommitFee(i interface{}) error {
+	return validateCoin("MinCommitFee", i)
+}
+
+func validateProceeds(i interface{}) error {
+	v, ok := i.(ProceedsPolicy)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", "Proceeds", i)
+	}
+
+	return v.Validate()
+}
+
+// WithDefaults returns the params with unset values (e.g. missing from a genesis file exported
+// before the params were added) replaced by the defaults.
+func (p Params) WithDefaults() Params {
+	defaults := DefaultParams()
+
+	if p.CommitsDuration == 0 {
+		p.CommitsDuration = defaults.CommitsDuration
+	}
+
+	if p.RevealsDuration == 0 {
+		p.RevealsDuration = defaults.RevealsDuration
+	}
+
+	if p.CommitFee.Denom == "" {
+		p.CommitFee = defaults.CommitFee
+	}
+
+	if p.RevealFee.Denom == "" {
+		p.RevealFee = defaults.RevealFee
+	}
+
+	if p.MinimumBid.Denom == "" {
+		p.MinimumBid = defaults.MinimumBid
+	}
+
+	if p.MaxCommitsDuration == 0 {
+		p.MaxCommitsDuration = defaults.MaxCommitsDuration
+	}
+
+	if p.MaxRevealsDuration == 0 {
+		p.MaxRevealsDuration = defaults.MaxRevealsDuration
+	}
+
+	if p.MinCommitFee.Denom == "" {
+		p.MinCommitFee = defaults.MinCommitFee
+	}
+
+	return p
+}
+
 // Validate a set of params.
 func (p Params) Validate() error {
+	if err := validateCommitsDuration(p.CommitsDuration); err != nil {
+		return err
+	}
+
+	if err := validateRevealsDuration(p.RevealsDuration); err != nil {
+		return err
+	}
+
+	if err := validateCommitFee(p.CommitFee); err != nil {
+		return err
+	}
+
+	if err := validateRevealFee(p.RevealFee); err != nil {
+		return err
+	}
+
+	if err := validateMinimumBid(p.MinimumBid); err != nil {
+		return err
+	}
+
+	if err := validateMaxCommitsDuration(p.MaxCommitsDuration); err != nil {
+		return err
+	}
+
+	if err := validateMaxRevealsDuration(p.MaxRevealsDuration); err != nil {
+		return err
+	}
+
+	if err := validateMinCommitFee(p.MinCommitFee); err != nil {
+		return err
+	}
+
+	if err := validateProceeds(p.Proceeds); err != nil {
+		return err
+	}
+
+	// Defaults for new auctions must be within the limits.
+	if p.CommitsDuration > p.MaxCommitsDuration {
+		return fmt.Errorf("CommitsDuration can't be more than MaxCommitsDuration")
+	}
+
+	if p.RevealsDuration > p.MaxRevealsDuration {
+		return fmt.Errorf("RevealsDuration can't be more than MaxRevealsDuration")
+	}
+
+	return p.CheckCommitFee(p.CommitFee)
+}
+
+// CheckCommitFee checks the commit fee against the min. commit fee.
+func (p Params) CheckCommitFee(commitFee sdk.Coin) error {
+	if p.MinCommitFee.IsZero() {
+		return nil
+	}
+
+	if commitFee.Denom != p.MinCommitFee.Denom || commitFee.IsLT(p.MinCommitFee) {
+		return fmt.Errorf("commit fee must be at least %s", p.MinCommitFee)
+	}
+
 	return nil
 }
@@ -0,0 +1,131 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Auction proceeds recipients (other than account addresses).
+const (
+	// Proceeds are sent to the auction burn module account.
+	ProceedsRecipientBurn = "burn"
+
+	// Proceeds are sent to the auction owner.
+	ProceedsRecipientOwner = "owner"
+
+	// Proceeds are sent to the community pool.
+	ProceedsRecipientCommunityPool = "community_pool"
+)
+
+// ProceedsShare is the percentage of the winner price sent to a recipient.
+type ProceedsShare struct {
+	// ProceedsRecipientBurn, ProceedsRecipientOwner, ProceedsRecipientCommunityPool or an account address.
+	Recipient string `json:"recipient" yaml:"recipient"`
+
+	Percent int64 `json:"percent" yaml:"percent"`
+}
+
+// ProceedsPolicy defines how the winner price is distributed, shares must add up to 100 percent.
+// An empty policy burns anything over the min. bid amount, the rest stays in the auction module account.
+type ProceedsPolicy []ProceedsShare
+
+// Validate checks the recipients and percentages.
+func (policy ProceedsPolicy) Validate() error {
+	if len(policy) == 0 {
+		return nil
+	}
+
+	var total int64
+	for _, share := range policy {
+		switch share.Recipient {
+		case ProceedsRecipientBurn, ProceedsRecipientOwner, ProceedsRecipientCommunityPool:
+		default:
+			if _, err := sdk.AccAddressFromBech32(share.Recipient); err != nil {
+				return fmt.Errorf("invalid proceeds recipient: %s", share.Recipient)
+			}
+		}
+
+		if share.Percent <= 0 || share.Percent > 100 {
+			return fmt.Errorf("invalid proceeds percentage: %d", share.Percent)
+		}
+
+		total += share.Percent
+	}
+
+	if total != 100 {
+		return fmt.Errorf("proceeds percentages must add up to 100")
+	}
+
+	return nil
+}
+
+// String returns the policy in the format parsed by ParseProceedsPolicy.
+func (policy ProceedsPolicy) String() string {
+	shares := make([]string, len(policy))
+	for index, share := range policy {
+		shares[index] = fmt.Sprintf("%s:%d", share.Recipient, share.Percent)
+	}
+
+	return strings.Join(shares, ",")
+}
+
+// ParseProceedsPolicy parses a policy of the form "owner:80,community_pool:20" (empty => empty policy).
+// A recipient without a percentage gets 100 percent, e.g. "burn".
+func ParseProceedsPolicy(str string) (ProceedsPolicy, error) {
+	policy := ProceedsPolicy{}
+
+	str = strings.TrimSpace(str)
+	if str == "" {
+		return policy, nil
+	}
+
+	for _, shareStr := range strings.Split(str, ",") {
+		parts := strings.Split(strings.TrimSpace(shareStr), ":")
+		if len(parts) > 2 {
+			return nil, fmt.Errorf("invalid proceeds share: %s", shareStr)
+		}
+
+		share := ProceedsShare{Recipient: parts[0], Percent: 100}
+		if len(parts) == 2 {
+			percent, err := strconv.ParseInt(parts[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid proceeds percentage: %s", parts[1])
+			}
+
+			share.Percent = percent
+		}
+
+		policy = append(policy, share)
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// Split returns the amount for each share, the last share gets any rounding remainder.
+func (policy ProceedsPolicy) Split(amount sdk.Coin) []sdk.Coin {
+	amounts := make([]sdk.Coin, len(policy))
+
+	remaining := amount.Amount
+	for index, share := range policy {
+		shareAmount := amount.Amount.MulRaw(share.Percent).QuoRaw(100)
+		if index == len(policy)-1 {
+			shareAmount = remaining
+		}
+
+		remaining = remaining.Sub(shareAmount)
+		amounts[index] = sdk.NewCoin(amount.Denom, shareAmount)
+	}
+
+	return amounts
+}
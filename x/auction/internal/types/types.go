@@ -13,6 +13,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Auction kinds.
+const (
+	// Sealed-bid, 2nd price (Vickrey) auction.
+	AuctionKindVickrey = "vickrey"
+
+	// Sealed-bid, 1st price auction.
+	AuctionKindFirstPrice = "first_price"
+
+	// Open, descending price auction, the price decays every block until a bid accepts it.
+	AuctionKindDutch = "dutch"
+
+	// Open, ascending price auction, bids close to the end extend the auction (anti-sniping).
+	AuctionKindEnglish = "english"
+)
+
 // Auction status values.
 const (
 	// Auction is in commit phase.
@@ -21,6 +36,9 @@ const (
 	// Auction is in reveal phase.
 	AuctionStatusRevealPhase = "reveal"
 
+	// Auction is accepting open bids (Dutch and English auctions).
+	AuctionStatusBiddingPhase = "bidding"
+
 	// Auction has ended (no reveals allowed).
 	AuctionStatusExpired = "expired"
 
@@ -37,11 +55,14 @@ const (
 // ID for auctions.
 type ID string
 
-// Auction is a 2nd price sealed-bid on-chain auction.
+// Auction is an on-chain auction, a 2nd price sealed-bid auction by default (see Kind).
 type Auction struct {
 	ID     ID     `json:"id,omitempty"`
 	Status string `json:"status,omitempty"`
 
+	// Auction kind (empty => AuctionKindVickrey).
+	Kind string `json:"kind,omitempty"`
+
 	// Creator of the auction.
 	OwnerAddress string `json:"ownerAddress,omitempty"`
 
@@ -52,25 +73,42 @@ type Auction struct {
 	CommitsEndTime time.Time `json:"commitsEndTime,omitempty"`
 
 	// Time when the reveal phase ends.
+	// For open auctions, bids are accepted until the commits end time and both end times are the same.
 	RevealsEndTime time.Time `json:"revealsEndTime,omitempty"`
 
+	// Block height at which the auction was created.
+	CreateHeight int64 `json:"createHeight,omitempty"`
+
 	// Commit Fee + Reveal Fee both need to be paid when committing a bid.
 	// Reveal Fee is returned ONLY if the bid is revealed.
 	CommitFee sdk.Coin `json:"commitFee,omitempty"`
 	RevealFee sdk.Coin `json:"revealFee,omitempty"`
 
 	// Minimum bid for a valid commit.
+	// For Dutch auctions, the price doesn't decay below the minimum bid.
 	MinimumBid sdk.Coin `json:"minimumBid,omitempty"`
 
+	// Dutch auction start price, and price decrement per block.
+	StartPrice     sdk.Coin `json:"startPrice,omitempty"`
+	PriceDecrement sdk.Coin `json:"priceDecrement,omitempty"`
+
+	// English auction min. increment over the highest bid (zero => any higher bid).
+	BidIncrement sdk.Coin `json:"bidIncrement,omitempty"`
+
+	// English auction anti-sniping, bids within the extension window before the end extend the auction by the window.
+	ExtensionWindow time.Duration `json:"extensionWindow,omitempty"`
+
 	// Winner address.
 	WinnerAddress string `json:"winnerAddress,omitempty"`
 
 	// Winning bid, i.e. highest bid.
 	WinnerBid sdk.Coin `json:"winnerBid,omitempty"`
 
-	// Amount winner actually pays, i.e. 2nd highest bid.
-	// As it's a 2nd price auction.
+	// Amount winner actually pays, i.e. 2nd highest bid for Vickrey auctions, the winning bid otherwise.
 	WinnerPrice sdk.Coin `json:"winnerPrice,omitempty"`
+
+	// Distribution of the winner price.
+	Proceeds ProceedsPolicy `json:"proceeds,omitempty"`
 }
 
 // Bid represents a sealed bid (commit) made during the auction.
@@ -101,6 +139,61 @@ func (auctionID AuctionID) Generate() string {
 	return hex.EncodeToString(hasher.Sum(nil))
 }
 
+// IsOpen returns true if the auction is accepting commits, reveals or bids.
+func (auction Auction) IsOpen() bool {
+	switch auction.Status {
+	case AuctionStatusCommitPhase, AuctionStatusRevealPhase, AuctionStatusBiddingPhase:
+		return true
+	}
+
+	return false
+}
+
+// GetKind returns the auction kind.
+func (auction Auction) GetKind() string {
+	return GetAuctionKind(auction.Kind)
+}
+
+// GetAuctionKind returns the auction kind, defaulting to AuctionKindVickrey.
+func GetAuctionKind(kind string) string {
+	if kind == "" {
+		return AuctionKindVickrey
+	}
+
+	return kind
+}
+
+// IsValidAuctionKind checks if the kind is supported (empty => AuctionKindVickrey).
+func IsValidAuctionKind(kind string) bool {
+	switch GetAuctionKind(kind) {
+	case AuctionKindVickrey, AuctionKindFirstPrice, AuctionKindDutch, AuctionKindEnglish:
+		return true
+	}
+
+	return false
+}
+
+// IsSealedBidAuctionKind returns true for auction kinds with a commit/reveal phase.
+func IsSealedBidAuctionKind(kind string) bool {
+	kind = GetAuctionKind(kind)
+	return kind == AuctionKindVickrey || kind == AuctionKindFirstPrice
+}
+
+// GetDutchPrice returns the Dutch auction price at the given block height.
+func (auction Auction) GetDutchPrice(height int64) sdk.Coin {
+	blocks := height - auction.CreateHeight
+	if blocks < 0 {
+		blocks = 0
+	}
+
+	price := auction.StartPrice.Amount.Sub(auction.PriceDecrement.Amount.MulRaw(blocks))
+	if price.LT(auction.MinimumBid.Amount) {
+		price = auction.MinimumBid.Amount
+	}
+
+	return sdk.NewCoin(auction.StartPrice.Denom, price)
+}
+
 func (auction Auction) GetCreateTime() string {
 	return string(sdk.FormatTimeBytes(auction.CreateTime))
 }
@@ -17,6 +17,7 @@ const (
 
 var (
 	DefaultParamspace = types.DefaultParamspace
+	DefaultParams     = types.DefaultParams
 	NewKeeper         = keeper.NewKeeper
 	NewQuerier        = keeper.NewQuerier
 	ModuleCdc         = types.ModuleCdc
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/bond/internal/types"
 )
 
@@ -39,7 +40,7 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 // GetCmdList queries all bonds.
 func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List bonds.",
 		Args:  cobra.ExactArgs(0),
@@ -48,7 +49,12 @@ func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list", queryRoute), nil)
+			pagination, err := wnsUtils.GetPaginationFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list", queryRoute), pagination)
 			if err != nil {
 				return err
 			}
@@ -58,6 +64,10 @@ func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+
+	wnsUtils.AddPaginationFlags(cmd, "bond ID")
+
+	return cmd
 }
 
 // GetCmdGetBond queries a bond.
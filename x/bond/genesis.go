@@ -7,6 +7,7 @@ package bond
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/bond/internal/types"
 )
 
@@ -44,7 +45,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	params := keeper.GetParams(ctx)
-	bonds := keeper.ListBonds(ctx)
+	bonds := keeper.ListBonds(ctx, wnsUtils.Pagination{})
 
 	return GenesisState{Params: params, Bonds: bonds}
 }
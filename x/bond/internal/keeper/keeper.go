@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/bond/internal/types"
 )
 
@@ -46,8 +47,9 @@ type Keeper struct {
 type BondClientKeeper interface {
 	HasBond(ctx sdk.Context, id types.ID) bool
 	GetBond(ctx sdk.Context, id types.ID) types.Bond
-	MatchBonds(ctx sdk.Context, matchFn func(*types.Bond) bool) []*types.Bond
+	MatchBonds(ctx sdk.Context, matchFn func(*types.Bond) bool, pagination wnsUtils.Pagination) []*types.Bond
 	TransferCoinsToModuleAccount(ctx sdk.Context, id types.ID, moduleAccount string, coins sdk.Coins) error
+	TransferCoinsFromModuleAccount(ctx sdk.Context, id types.ID, moduleAccount string, coins sdk.Coins) error
 	TranserCoinsToAccount(ctx sdk.Context, id types.ID, account sdk.AccAddress, coins sdk.Coins) error
 }
 
@@ -112,14 +114,14 @@ func (k Keeper) GetBond(ctx sdk.Context, id types.ID) types.Bond {
 	return obj
 }
 
-// ListBonds - get all bonds.
-func (k Keeper) ListBonds(ctx sdk.Context) []types.Bond {
+// ListBonds - get all bonds (page).
+func (k Keeper) ListBonds(ctx sdk.Context, pagination wnsUtils.Pagination) []types.Bond {
 	var bonds []types.Bond
 
 	store := ctx.KVStore(k.storeKey)
-	itr := sdk.KVStorePrefixIterator(store, prefixIDToBondIndex)
+	itr := wnsUtils.PaginatedIterator(store, prefixIDToBondIndex, pagination)
 	defer itr.Close()
-	for ; itr.Valid(); itr.Next() {
+	for ; itr.Valid() && !pagination.IsFull(len(bonds)); itr.Next() {
 		bz := store.Get(itr.Key())
 		if bz != nil {
 			var obj types.Bond
@@ -152,14 +154,14 @@ func (k Keeper) QueryBondsByOwner(ctx sdk.Context, ownerAddress string) []types.
 	return bonds
 }
 
-// MatchBonds - get all matching bonds.
-func (k Keeper) MatchBonds(ctx sdk.Context, matchFn func(*types.Bond) bool) []*types.Bond {
+// MatchBonds - get all matching bonds (page).
+func (k Keeper) MatchBonds(ctx sdk.Context, matchFn func(*types.Bond) bool, pagination wnsUtils.Pagination) []*types.Bond {
 	var bonds []*types.Bond
 
 	store := ctx.KVStore(k.storeKey)
-	itr := sdk.KVStorePrefixIterator(store, prefixIDToBondIndex)
+	itr := wnsUtils.PaginatedIterator(store, prefixIDToBondIndex, pagination)
 	defer itr.Close()
-	for ; itr.Valid(); itr.Next() {
+	for ; itr.Valid() && !pagination.IsFull(len(bonds)); itr.Next() {
 		bz := store.Get(itr.Key())
 		if bz != nil {
 			var obj types.Bond
@@ -351,6 +353,27 @@ func (k Keeper) TransferCoinsToModuleAccount(ctx sdk.Context, id types.ID, modul
 	return nil
 }
 
+// TransferCoinsFromModuleAccount moves funds from a module account back to the bond (e.g. rent refunds).
+func (k Keeper) TransferCoinsFromModuleAccount(ctx sdk.Context, id types.ID, moduleAccount string, coins sdk.Coins) error {
+	if !k.HasBond(ctx, id) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Bond not found.")
+	}
+
+	bondObj := k.GetBond(ctx, id)
+
+	// Move funds from module account to bond module.
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, moduleAccount, types.ModuleName, coins)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Error transfering funds.")
+	}
+
+	// Update bond balance.
+	bondObj.Balance = bondObj.Balance.Add(coins...)
+	k.SaveBond(ctx, bondObj)
+
+	return nil
+}
+
 // TranserCoinsToAccount moves coins from the bond to an account.
 func (k Keeper) TranserCoinsToAccount(ctx sdk.Context, id types.ID, account sdk.AccAddress, coins sdk.Coins) error {
 	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Not implemented.")
@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/bond/internal/types"
 )
 
@@ -46,7 +47,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 
 // nolint: unparam
 func listBonds(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
-	bonds := keeper.ListBonds(ctx)
+	pagination, err := wnsUtils.ParsePagination(req.Data)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid pagination params.")
+	}
+
+	bonds := keeper.ListBonds(ctx, pagination)
 
 	bz, err2 := json.MarshalIndent(bonds, "", "  ")
 	if err2 != nil {
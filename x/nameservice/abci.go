@@ -14,6 +14,8 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	k.ProcessRecordExpiryQueue(ctx)
 	k.ProcessAuthorityExpiryQueue(ctx)
+	k.ProcessAuthorityCommitmentQueue(ctx)
+	k.ProcessApprovalExpiryQueue(ctx)
 
 	return []abci.ValidatorUpdate{}
 }
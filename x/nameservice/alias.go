@@ -25,6 +25,12 @@ var (
 	ModuleCdc         = types.ModuleCdc
 	RegisterCodec     = types.RegisterCodec
 
+	NormalizeName = types.NormalizeName
+	NormalizeWRN  = types.NormalizeWRN
+
+	NewNameListChangeProposal   = types.NewNameListChangeProposal
+	NewAuthorityDisputeProposal = types.NewAuthorityDisputeProposal
+
 	RegisterInvariants = keeper.RegisterInvariants
 
 	PrefixCIDToRecordIndex         = keeper.PrefixCIDToRecordIndex
@@ -39,12 +45,26 @@ var (
 	HasRecord        = keeper.HasRecord
 	GetRecord        = keeper.GetRecord
 	ResolveWRN       = keeper.ResolveWRN
+	GetAliasChain    = keeper.GetAliasChain
 	GetNameAuthority = keeper.GetNameAuthority
 	GetNameRecord    = keeper.GetNameRecord
 	MatchRecords     = keeper.MatchRecords
 	KeySyncStatus    = keeper.KeySyncStatus
 
+	KeySyncIndexVersion = keeper.KeySyncIndexVersion
+
+	IndexedAttributes           = keeper.IndexedAttributes
+	IsIndexedAttribute          = keeper.IsIndexedAttribute
+	MatchIndexedRecords         = keeper.MatchIndexedRecords
+	AddRecordToAttributeIndexes = keeper.AddRecordToAttributeIndexes
+	ReindexRecordAttributes     = keeper.ReindexRecordAttributes
+
+	AddRecordToReferenceIndex = keeper.AddRecordToReferenceIndex
+	GetReferencingRecordIDs   = keeper.GetReferencingRecordIDs
+	ReindexRecordReferences   = keeper.ReindexRecordReferences
+
 	SetNameRecord             = keeper.SetNameRecord
+	SetNameRecordEntry        = keeper.SetNameRecordEntry
 	AddRecordToNameMapping    = keeper.AddRecordToNameMapping
 	RemoveRecordToNameMapping = keeper.RemoveRecordToNameMapping
 )
@@ -55,6 +75,10 @@ type (
 
 	MsgSetRecord = types.MsgSetRecord
 
+	NameListChangeProposal   = types.NameListChangeProposal
+	AuthorityDisputeProposal = types.AuthorityDisputeProposal
+	ReservedName             = types.ReservedName
+
 	ID        = types.ID
 	Record    = types.Record
 	RecordObj = types.RecordObj
@@ -63,5 +87,7 @@ type (
 	NameRecord      = types.NameRecord
 	NameRecordEntry = types.NameRecordEntry
 
+	RecordSchema = types.RecordSchema
+
 	BlockChangeset = types.BlockChangeset
 )
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/nameservice/internal/types"
 )
 
@@ -35,10 +36,17 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdList(storeKey, cdc),
 		GetCmdGetResource(storeKey, cdc),
 		GetCmdQueryByBond(storeKey, cdc),
+		GetCmdReferencedBy(storeKey, cdc),
+		GetCmdGetRecordSchema(storeKey, cdc),
+		GetCmdListRecordSchemas(storeKey, cdc),
+		GetCmdListApprovals(storeKey, cdc),
+		GetCmdListGrants(storeKey, cdc),
+		GetCmdNameLists(storeKey, cdc),
 		GetCmdQueryParams(storeKey, cdc),
 		GetCmdBalance(storeKey, cdc),
 		GetRecordExpiryQueue(storeKey, cdc),
 		GetAuthorityExpiryQueue(storeKey, cdc),
+		GetCmdAuthorityRenewal(storeKey, cdc),
 	)...)
 	return nameserviceQueryCmd
 }
@@ -91,7 +99,7 @@ func GetCmdLookupWRN(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 // GetCmdList queries all records.
 func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List records.",
 		Args:  cobra.ExactArgs(0),
@@ -100,7 +108,12 @@ func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list", queryRoute), nil)
+			pagination, err := wnsUtils.GetPaginationFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list", queryRoute), pagination)
 			if err != nil {
 				return err
 			}
@@ -110,6 +123,10 @@ func GetCmdList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+
+	wnsUtils.AddPaginationFlags(cmd, "record ID")
+
+	return cmd
 }
 
 // GetCmdGetResource queries a record record.
@@ -137,7 +154,7 @@ func GetCmdGetResource(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 // GetCmdNames queries all naming records.
 func GetCmdNames(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "names",
 		Short: "List name records.",
 		Args:  cobra.ExactArgs(0),
@@ -146,7 +163,12 @@ func GetCmdNames(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/names", queryRoute), nil)
+			pagination, err := wnsUtils.GetPaginationFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/names", queryRoute), pagination)
 			if err != nil {
 				return err
 			}
@@ -156,6 +178,10 @@ func GetCmdNames(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+
+	wnsUtils.AddPaginationFlags(cmd, "WRN")
+
+	return cmd
 }
 
 // GetCmdResolve resolves a WRN to a record.
@@ -163,7 +189,18 @@ func GetCmdResolve(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "resolve [wrn]",
 		Short: "Resolve WRN to record.",
-		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resolve WRN to record.
+A version (semver range) suffix resolves to the highest matching version.
+
+Example:
+$ %s query nameservice resolve wrn://example/app@^1.2.0
+$ %s query nameservice resolve wrn://example/app@latest
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			viper.Set("trust-node", true)
 
@@ -182,6 +219,29 @@ func GetCmdResolve(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdReferencedBy queries records referencing a record.
+func GetCmdReferencedBy(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "referenced-by [id]",
+		Short: "Query records referencing a record.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper.Set("trust-node", true)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id := args[0]
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/referenced-by/%s", queryRoute, id), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
 // GetCmdQueryByBond queries records by bond ID.
 func GetCmdQueryByBond(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -205,6 +265,121 @@ func GetCmdQueryByBond(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdGetRecordSchema queries the schema registered for a record type.
+func GetCmdGetRecordSchema(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema [type]",
+		Short: "Get record type schema.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper.Set("trust-node", true)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			recordType := args[0]
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/schema/%s", queryRoute, recordType), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+// GetCmdListRecordSchemas queries all record type schemas.
+func GetCmdListRecordSchemas(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "schemas",
+		Short: "List record type schemas.",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper.Set("trust-node", true)
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/schemas", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+// GetCmdListApprovals queries pending (multisig) approvals.
+func GetCmdListApprovals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "approvals",
+		Short: "List pending owner approvals.",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper.Set("trust-node", true)
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/approvals", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+// GetCmdListGrants queries the grants for an authority.
+func GetCmdListGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants [name]",
+		Short: "List name access grants for authority.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper.Set("trust-node", true)
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/grants/%s", queryRoute, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+// GetCmdNameLists queries the governance-managed blocked and reserved name lists.
+func GetCmdNameLists(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "name-lists",
+		Short: "List blocked and reserved names.",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper.Set("trust-node", true)
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/name-lists", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
 // GetCmdQueryParams implements the params query command.
 func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -302,3 +477,34 @@ func GetAuthorityExpiryQueue(queryRoute string, cdc *codec.Codec) *cobra.Command
 		},
 	}
 }
+
+// GetCmdAuthorityRenewal queries the projected expiry time and cost of renewing an authority.
+func GetCmdAuthorityRenewal(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authority-renewal [name]",
+		Short: "Get projected expiry time and cost of renewing authority.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper.Set("trust-node", true)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			periods, err := cmd.Flags().GetInt64("periods")
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/authority-renewal/%s/%d", queryRoute, args[0], periods), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64("periods", 1, "Number of rent periods to pay for.")
+
+	return cmd
+}
@@ -6,9 +6,13 @@ package cli
 
 import (
 	"bufio"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/spf13/cobra"
@@ -21,8 +25,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/nameservice/internal/helpers"
 	"github.com/vulcanize/dxns/x/nameservice/internal/types"
 )
@@ -40,15 +47,29 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	nameserviceTxCmd.AddCommand(flags.PostCommands(
 		GetCmdSetRecord(cdc),
 		GetCmdRenewRecord(cdc),
+		GetCmdDeleteRecord(cdc),
+		GetCmdSetRecordAutoRenew(cdc),
+		GetCmdSetRecordSchema(cdc),
 		GetCmdAssociateBond(cdc),
 		GetCmdDissociateBond(cdc),
 		GetCmdDissociateRecords(cdc),
 		GetCmdReassociateRecords(cdc),
 
 		GetCmdReserveName(cdc),
+		GetCmdCommitName(cdc),
+		GetCmdRevealName(cdc),
 		GetCmdSetAuthorityBond(cdc),
+		GetCmdRenewAuthority(cdc),
+		GetCmdRedeemAuthority(cdc),
+		GetCmdSetAuthorityPolicy(cdc),
+		GetCmdSetSubAuthorityPolicy(cdc),
+		GetCmdTransferAuthority(cdc),
+		GetCmdAcceptAuthority(cdc),
+		GetCmdGrantNameAccess(cdc),
+		GetCmdRevokeNameAccess(cdc),
 		GetCmdSetName(cdc),
 		GetCmdDeleteName(cdc),
+		GetCmdSetAlias(cdc),
 	)...)
 
 	return nameserviceTxCmd
@@ -76,6 +97,7 @@ func GetCmdSetRecord(cdc *codec.Codec) *cobra.Command {
 			}
 
 			msg := types.NewMsgSetRecord(payload.ToPayloadObj(), args[1], cliCtx.GetFromAddress())
+			msg.OwnerThreshold = viper.GetInt64("owner-threshold")
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -86,6 +108,37 @@ func GetCmdSetRecord(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().Bool("sign-only", false, "Only sign the transaction payload.")
+	cmd.Flags().Int64("owner-threshold", 0, "Number of owner approvals required for record mutations (default 1).")
+
+	return cmd
+}
+
+// GetCmdSetRecordSchema is the CLI command for publishing a record type schema.
+func GetCmdSetRecordSchema(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-schema [type] [authority] [schema file path]",
+		Short: "Set JSON Schema for record type.",
+		Long:  "Set JSON Schema for record type. The type must be under the authority, e.g. wrn://acme/app.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			schema, err := ioutil.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRecordSchema(args[0], args[1], string(schema), cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
 
 	return cmd
 }
@@ -186,18 +239,78 @@ func GetCmdReassociateRecords(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
-// GetCmdRenewRecord is the CLI command for renewing an expired record.
+// GetCmdRenewRecord is the CLI command for renewing a record.
 func GetCmdRenewRecord(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "renew-record [record-id]",
-		Short: "Renew (expired) record.",
+		Short: "Renew record (live records are extended from the current expiry time).",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
-			msg := types.NewMsgRenewRecord(args[0], cliCtx.GetFromAddress())
+			periods := viper.GetInt64("periods")
+
+			msg := types.NewMsgRenewRecord(args[0], periods, cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64("periods", 1, "Number of rent periods to pay for.")
+
+	return cmd
+}
+
+// GetCmdSetRecordAutoRenew is the CLI command for turning record auto-renewal on/off.
+func GetCmdSetRecordAutoRenew(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-renew [record-id] [true|false]",
+		Short: "Turn automatic renewal (from the record bond) on expiry on/off.",
+		Long: `Turn automatic renewal (from the record bond) on expiry on/off.
+
+Auto-renewal is on by default, turn it off to let the record expire.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			autoRenew, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRecordAutoRenew(args[0], autoRenew, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdDeleteRecord is the CLI command for deleting a record.
+func GetCmdDeleteRecord(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-record [record-id]",
+		Short: "Delete record (unused rent is refunded to the record bond).",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgDeleteRecord(args[0], cliCtx.GetFromAddress())
 			err := msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -243,6 +356,344 @@ func GetCmdReserveName(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdCommitName is the CLI command for committing to a (hidden) root name reservation.
+func GetCmdCommitName(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-name [name]",
+		Short: "Commit to reserving a root name (saves the reveal file, to be revealed using reveal-name).",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			name, err := types.NormalizeName(args[0])
+			if err != nil {
+				return err
+			}
+
+			mnemonic, err := wnsUtils.GenerateMnemonic()
+			if err != nil {
+				return err
+			}
+
+			reveal := map[string]interface{}{
+				"chainId":      viper.GetString("chain-id"),
+				"name":         name,
+				"ownerAddress": cliCtx.GetFromAddress().String(),
+				"noise":        mnemonic,
+			}
+
+			commitHash, content, err := wnsUtils.GenerateHash(reveal)
+			if err != nil {
+				return err
+			}
+
+			// Save reveal file.
+			err = ioutil.WriteFile(fmt.Sprintf("%s-%s.json", cliCtx.GetFromName(), commitHash), content, 0600)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCommitAuthority(commitHash, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdRevealName is the CLI command for revealing a committed root name reservation.
+func GetCmdRevealName(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-name [reveal-file-path]",
+		Short: "Reveal (and reserve) a root name committed to earlier.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			revealBytes, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealAuthority(hex.EncodeToString(revealBytes), cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdRenewAuthority is the CLI command for prepaying authority rent.
+func GetCmdRenewAuthority(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew-authority [name]",
+		Short: "Renew authority (prepay rent from the authority bond, extending the current expiry time).",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			periods := viper.GetInt64("periods")
+
+			msg := types.NewMsgRenewAuthority(args[0], periods, cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64("periods", 1, "Number of rent periods to pay for.")
+
+	return cmd
+}
+
+// GetCmdRedeemAuthority is the CLI command for restoring an authority during its redemption period.
+func GetCmdRedeemAuthority(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-authority [name]",
+		Short: "Restore expired authority during its redemption period (pays rent plus penalty from the authority bond).",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRedeemAuthority(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSetAuthorityPolicy is the CLI command for setting the M-of-N owner policy for an authority.
+func GetCmdSetAuthorityPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authority-policy [name] [threshold] [owner-address...]",
+		Short: "Set M-of-N owner policy for authority (threshold 0 without owners clears the policy).",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			threshold, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAuthorityPolicy(args[0], args[2:], threshold, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSetSubAuthorityPolicy is the CLI command for setting the sub-authority issuance policy for an authority.
+func GetCmdSetSubAuthorityPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sub-authority-policy [name]",
+		Short: "Set policy for third parties reserving sub-authorities.",
+		Long: `Set policy for third parties reserving sub-authorities.
+
+Without --open or --allow, only the authority owner can reserve sub-authorities.
+The fee is paid to the authority bond (or owner, if the authority has no bond).`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			fee, err := sdk.ParseCoins(viper.GetString("fee"))
+			if err != nil {
+				return err
+			}
+
+			policy := types.SubAuthorityPolicy{
+				Open:      viper.GetBool("open"),
+				AllowList: viper.GetStringSlice("allow"),
+				Fee:       fee,
+				MaxDepth:  viper.GetInt64("max-depth"),
+			}
+
+			msg := types.NewMsgSetSubAuthorityPolicy(args[0], policy, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool("open", false, "Allow anyone to reserve sub-authorities.")
+	cmd.Flags().StringSlice("allow", []string{}, "Addresses allowed to reserve sub-authorities.")
+	cmd.Flags().String("fee", "", "Fee per sub-authority (e.g. 10wire).")
+	cmd.Flags().Int64("max-depth", 0, "Max. depth of sub-authorities (0 => no limit).")
+
+	return cmd
+}
+
+// GetCmdTransferAuthority is the CLI command for transferring ownership of an authority.
+func GetCmdTransferAuthority(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-authority [name] [new-owner-address]",
+		Short: "Transfer authority ownership.",
+		Long: `Transfer authority ownership.
+
+With --two-step, the new owner has to accept the transfer (see accept-authority) before ownership changes.
+A two-step transfer to the current owner cancels a pending transfer.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferAuthority(args[0], newOwner, viper.GetBool("two-step"), cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool("two-step", false, "Require the new owner to accept the transfer.")
+
+	return cmd
+}
+
+// GetCmdAcceptAuthority is the CLI command for accepting a pending authority transfer.
+func GetCmdAcceptAuthority(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-authority [name]",
+		Short: "Accept pending authority transfer.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgAcceptAuthority(args[0], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdGrantNameAccess is the CLI command for granting write access to names under an authority.
+func GetCmdGrantNameAccess(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-name-access [wrn-pattern] [grantee-address] [permission...]",
+		Short: "Grant write access to names under authority.",
+		Long: `Grant write access to names under authority.
+
+The pattern is a WRN, a trailing * matches any suffix (e.g. wrn://acme/ci/*).
+Permissions: set-name, delete-name, set-alias.`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			var expiryTime time.Time
+			if expiry := viper.GetString("expiry-time"); expiry != "" {
+				expiryTime, err = time.Parse(time.RFC3339, expiry)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgGrantNameAccess(args[0], grantee, args[2:], expiryTime.UTC(), cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String("expiry-time", "", "Grant expiry time, RFC3339 format (default: no expiry).")
+
+	return cmd
+}
+
+// GetCmdRevokeNameAccess is the CLI command for revoking a name access grant.
+func GetCmdRevokeNameAccess(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-name-access [wrn-pattern] [grantee-address]",
+		Short: "Revoke name access grant.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeNameAccess(args[0], grantee, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
 // GetCmdSetAuthorityBond is the CLI command for associating a bond with an authority.
 func GetCmdSetAuthorityBond(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -291,6 +742,30 @@ func GetCmdSetName(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdSetAlias is the CLI command for pointing a name at another WRN.
+func GetCmdSetAlias(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-alias [wrn] [target-wrn]",
+		Short: "Set WRN to (target) WRN mapping.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgSetAlias(args[0], args[1], cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
 func GetCmdDeleteName(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete-name [wrn]",
@@ -380,3 +855,153 @@ func requestSignature(attributes map[string]interface{}, name string) (types.ID,
 
 	return cid, sigBytes, signedJSON, pubKey, nil
 }
+
+// NameListChangeProposalJSON defines a name list change proposal, read from a JSON file.
+type NameListChangeProposalJSON struct {
+	Title       string               `json:"title" yaml:"title"`
+	Description string               `json:"description" yaml:"description"`
+	Block       []string             `json:"block" yaml:"block"`
+	Unblock     []string             `json:"unblock" yaml:"unblock"`
+	Reserve     []types.ReservedName `json:"reserve" yaml:"reserve"`
+	Unreserve   []string             `json:"unreserve" yaml:"unreserve"`
+	Deposit     sdk.Coins            `json:"deposit" yaml:"deposit"`
+}
+
+// GetCmdSubmitNameListChangeProposal is the CLI command for submitting a name list change proposal.
+func GetCmdSubmitNameListChangeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "name-list-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a blocked/reserved name list change proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a blocked/reserved name list change proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal name-list-change <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Reserve protocol names",
+  "description": "Block wns, reserve dxns for the foundation.",
+  "block": ["wns"],
+  "reserve": [
+    {
+      "name": "dxns",
+      "address": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq"
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "uwire",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			var proposal NameListChangeProposalJSON
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			content := types.NewNameListChangeProposal(proposal.Title, proposal.Description, proposal.Block, proposal.Unblock, proposal.Reserve, proposal.Unreserve)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// AuthorityDisputeProposalJSON defines an authority dispute proposal, read from a JSON file.
+type AuthorityDisputeProposalJSON struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Name        string    `json:"name" yaml:"name"`
+	Action      string    `json:"action" yaml:"action"`
+	NewOwner    string    `json:"new_owner" yaml:"new_owner"`
+	Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+}
+
+// GetCmdSubmitAuthorityDisputeProposal is the CLI command for submitting an authority dispute proposal.
+func GetCmdSubmitAuthorityDisputeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authority-dispute [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to freeze, unfreeze, revoke or reassign an authority",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an authority dispute proposal along with an initial deposit.
+The action applies to the authority and all its sub-authorities.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal authority-dispute <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Reassign squatted authority",
+  "description": "Trademark dispute, reassign acme to its owner.",
+  "name": "acme",
+  "action": "reassign",
+  "new_owner": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "deposit": [
+    {
+      "denom": "uwire",
+      "amount": "10000"
+    }
+  ]
+}
+
+Action is one of freeze, unfreeze, revoke or reassign (new_owner is only used to reassign).
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			var proposal AuthorityDisputeProposalJSON
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			content := types.NewAuthorityDisputeProposal(proposal.Title, proposal.Description, proposal.Name, proposal.Action, proposal.NewOwner)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
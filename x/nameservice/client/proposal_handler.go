@@ -0,0 +1,18 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/vulcanize/dxns/x/nameservice/client/cli"
+	"github.com/vulcanize/dxns/x/nameservice/client/rest"
+)
+
+// ProposalHandler is the name list change proposal handler.
+// AuthorityDisputeProposalHandler is the authority dispute proposal handler.
+var (
+	ProposalHandler                 = govclient.NewProposalHandler(cli.GetCmdSubmitNameListChangeProposal, rest.ProposalRESTHandler)
+	AuthorityDisputeProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitAuthorityDisputeProposal, rest.AuthorityDisputeProposalRESTHandler)
+)
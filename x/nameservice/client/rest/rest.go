@@ -0,0 +1,108 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+// NameListChangeProposalReq defines a name list change proposal request body.
+type NameListChangeProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string               `json:"title" yaml:"title"`
+	Description string               `json:"description" yaml:"description"`
+	Block       []string             `json:"block" yaml:"block"`
+	Unblock     []string             `json:"unblock" yaml:"unblock"`
+	Reserve     []types.ReservedName `json:"reserve" yaml:"reserve"`
+	Unreserve   []string             `json:"unreserve" yaml:"unreserve"`
+	Proposer    sdk.AccAddress       `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins            `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns the REST handler for submitting name list change proposals.
+func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "name_list_change",
+		Handler:  postProposalHandlerFn(cliCtx),
+	}
+}
+
+func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req NameListChangeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewNameListChangeProposal(req.Title, req.Description, req.Block, req.Unblock, req.Reserve, req.Unreserve)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// AuthorityDisputeProposalReq defines an authority dispute proposal request body.
+type AuthorityDisputeProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Name        string         `json:"name" yaml:"name"`
+	Action      string         `json:"action" yaml:"action"`
+	NewOwner    string         `json:"new_owner" yaml:"new_owner"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// AuthorityDisputeProposalRESTHandler returns the REST handler for submitting authority dispute proposals.
+func AuthorityDisputeProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "authority_dispute",
+		Handler:  postAuthorityDisputeProposalHandlerFn(cliCtx),
+	}
+}
+
+func postAuthorityDisputeProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AuthorityDisputeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewAuthorityDisputeProposal(req.Title, req.Description, req.Name, req.Action, req.NewOwner)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
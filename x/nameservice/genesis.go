@@ -7,6 +7,7 @@ package nameservice
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/nameservice/internal/types"
 )
 
@@ -25,6 +26,11 @@ type GenesisState struct {
 	Records     []types.RecordObj `json:"records" yaml:"records"`
 	Authorities []AuthorityEntry  `json:"authorities" yaml:"authorities"`
 	Names       []NameEntry       `json:"names" yaml:"names"`
+	Schemas     []RecordSchema    `json:"schemas" yaml:"schemas"`
+
+	// Governance-managed name lists.
+	BlockedNames  []string             `json:"blocked_names" yaml:"blocked_names"`
+	ReservedNames []types.ReservedName `json:"reserved_names" yaml:"reserved_names"`
 }
 
 func NewGenesisState(params types.Params, records []types.RecordObj, authorities []AuthorityEntry, names []NameEntry) GenesisState {
@@ -55,6 +61,8 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 	for _, record := range data.Records {
 		obj := record.ToRecord()
 		keeper.PutRecord(ctx, obj)
+		keeper.AddRecordToAttributeIndexes(ctx, obj)
+		keeper.AddRecordToReferenceIndex(ctx, obj)
 
 		// Add to record expiry queue if expiry time is in the future.
 		if obj.ExpiryTime.After(ctx.BlockTime()) {
@@ -83,7 +91,23 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 	}
 
 	for _, nameEntry := range data.Names {
-		keeper.SetNameRecord(ctx, nameEntry.Name, nameEntry.Entry.ID)
+		if nameEntry.Entry.Alias != "" {
+			keeper.SetNameAlias(ctx, nameEntry.Name, nameEntry.Entry.Alias)
+		} else {
+			keeper.SetNameRecord(ctx, nameEntry.Name, nameEntry.Entry.ID)
+		}
+	}
+
+	for _, schema := range data.Schemas {
+		keeper.SetRecordSchema(ctx, schema)
+	}
+
+	for _, name := range data.BlockedNames {
+		keeper.SetNameBlocked(ctx, name, true)
+	}
+
+	for _, reserved := range data.ReservedNames {
+		keeper.SetReservedName(ctx, reserved)
 	}
 
 	return []abci.ValidatorUpdate{}
@@ -92,7 +116,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	params := keeper.GetParams(ctx)
 
-	records := keeper.ListRecords(ctx)
+	records := keeper.ListRecords(ctx, wnsUtils.Pagination{})
 	recordEntries := []types.RecordObj{}
 	for _, record := range records {
 		recordEntries = append(recordEntries, record.ToRecordObj())
@@ -107,7 +131,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		})
 	}
 
-	names := keeper.ListNameRecords(ctx)
+	names := keeper.ListNameRecords(ctx, wnsUtils.Pagination{})
 	nameEntries := []NameEntry{}
 	for name, record := range names {
 		nameEntries = append(nameEntries, NameEntry{
@@ -116,10 +140,16 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		})
 	}
 
+	schemas := keeper.ListRecordSchemas(ctx)
+
 	return GenesisState{
 		Params:      params,
 		Records:     recordEntries,
 		Authorities: authorityEntries,
 		Names:       nameEntries,
+		Schemas:     schemas,
+
+		BlockedNames:  keeper.ListBlockedNames(ctx),
+		ReservedNames: keeper.ListReservedNames(ctx),
 	}
 }
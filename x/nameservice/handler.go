@@ -23,8 +23,30 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgSetName(ctx, keeper, msg)
 		case types.MsgDeleteName:
 			return handleMsgDeleteName(ctx, keeper, msg)
+		case types.MsgSetAlias:
+			return handleMsgSetAlias(ctx, keeper, msg)
 		case types.MsgReserveAuthority:
 			return handleMsgReserveAuthority(ctx, keeper, msg)
+		case types.MsgSetAuthorityPolicy:
+			return handleMsgSetAuthorityPolicy(ctx, keeper, msg)
+		case types.MsgTransferAuthority:
+			return handleMsgTransferAuthority(ctx, keeper, msg)
+		case types.MsgAcceptAuthority:
+			return handleMsgAcceptAuthority(ctx, keeper, msg)
+		case types.MsgGrantNameAccess:
+			return handleMsgGrantNameAccess(ctx, keeper, msg)
+		case types.MsgRevokeNameAccess:
+			return handleMsgRevokeNameAccess(ctx, keeper, msg)
+		case types.MsgSetSubAuthorityPolicy:
+			return handleMsgSetSubAuthorityPolicy(ctx, keeper, msg)
+		case types.MsgRenewAuthority:
+			return handleMsgRenewAuthority(ctx, keeper, msg)
+		case types.MsgRedeemAuthority:
+			return handleMsgRedeemAuthority(ctx, keeper, msg)
+		case types.MsgCommitAuthority:
+			return handleMsgCommitAuthority(ctx, keeper, msg)
+		case types.MsgRevealAuthority:
+			return handleMsgRevealAuthority(ctx, keeper, msg)
 		case types.MsgSetAuthorityBond:
 			return handleMsgSetAuthorityBond(ctx, keeper, msg)
 		case types.MsgAssociateBond:
@@ -37,6 +59,12 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgReassociateRecords(ctx, keeper, msg)
 		case types.MsgRenewRecord:
 			return handleMsgRenewRecord(ctx, keeper, msg)
+		case types.MsgDeleteRecord:
+			return handleMsgDeleteRecord(ctx, keeper, msg)
+		case types.MsgSetRecordAutoRenew:
+			return handleMsgSetRecordAutoRenew(ctx, keeper, msg)
+		case types.MsgSetRecordSchema:
+			return handleMsgSetRecordSchema(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -70,6 +98,45 @@ func handleMsgRenewRecord(ctx sdk.Context, keeper Keeper, msg types.MsgRenewReco
 	}, nil
 }
 
+// Handle MsgDeleteRecord.
+func handleMsgDeleteRecord(ctx sdk.Context, keeper Keeper, msg types.MsgDeleteRecord) (*sdk.Result, error) {
+	record, err := keeper.ProcessDeleteRecord(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(record.ID),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// Handle MsgSetRecordAutoRenew.
+func handleMsgSetRecordAutoRenew(ctx sdk.Context, keeper Keeper, msg types.MsgSetRecordAutoRenew) (*sdk.Result, error) {
+	record, err := keeper.ProcessSetRecordAutoRenew(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(record.ID),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// Handle MsgSetRecordSchema.
+func handleMsgSetRecordSchema(ctx sdk.Context, keeper Keeper, msg types.MsgSetRecordSchema) (*sdk.Result, error) {
+	schema, err := keeper.ProcessSetRecordSchema(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(schema.Type),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
 // Handle MsgAssociateBond.
 func handleMsgAssociateBond(ctx sdk.Context, keeper Keeper, msg types.MsgAssociateBond) (*sdk.Result, error) {
 	record, err := keeper.ProcessAssociateBond(ctx, msg)
@@ -148,6 +215,136 @@ func handleMsgSetAuthorityBond(ctx sdk.Context, keeper Keeper, msg types.MsgSetA
 	}, nil
 }
 
+// Handle MsgSetAuthorityPolicy.
+func handleMsgSetAuthorityPolicy(ctx sdk.Context, keeper Keeper, msg types.MsgSetAuthorityPolicy) (*sdk.Result, error) {
+	err := keeper.ProcessSetAuthorityPolicy(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(msg.Name),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// Handle MsgTransferAuthority.
+func handleMsgTransferAuthority(ctx sdk.Context, keeper Keeper, msg types.MsgTransferAuthority) (*sdk.Result, error) {
+	err := keeper.ProcessTransferAuthority(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(msg.Name),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// Handle MsgAcceptAuthority.
+func handleMsgAcceptAuthority(ctx sdk.Context, keeper Keeper, msg types.MsgAcceptAuthority) (*sdk.Result, error) {
+	err := keeper.ProcessAcceptAuthority(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(msg.Name),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// Handle MsgGrantNameAccess.
+func handleMsgGrantNameAccess(ctx sdk.Context, keeper Keeper, msg types.MsgGrantNameAccess) (*sdk.Result, error) {
+	err := keeper.ProcessGrantNameAccess(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(msg.Pattern),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// Handle MsgRevokeNameAccess.
+func handleMsgRevokeNameAccess(ctx sdk.Context, keeper Keeper, msg types.MsgRevokeNameAccess) (*sdk.Result, error) {
+	err := keeper.ProcessRevokeNameAccess(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(msg.Pattern),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// Handle MsgSetSubAuthorityPolicy.
+func handleMsgSetSubAuthorityPolicy(ctx sdk.Context, keeper Keeper, msg types.MsgSetSubAuthorityPolicy) (*sdk.Result, error) {
+	err := keeper.ProcessSetSubAuthorityPolicy(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(msg.Name),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// Handle MsgRenewAuthority.
+func handleMsgRenewAuthority(ctx sdk.Context, keeper Keeper, msg types.MsgRenewAuthority) (*sdk.Result, error) {
+	err := keeper.ProcessRenewAuthority(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(msg.Name),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// Handle MsgRedeemAuthority.
+func handleMsgRedeemAuthority(ctx sdk.Context, keeper Keeper, msg types.MsgRedeemAuthority) (*sdk.Result, error) {
+	err := keeper.ProcessRedeemAuthority(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(msg.Name),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// Handle MsgCommitAuthority.
+func handleMsgCommitAuthority(ctx sdk.Context, keeper Keeper, msg types.MsgCommitAuthority) (*sdk.Result, error) {
+	err := keeper.ProcessCommitAuthority(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(msg.CommitHash),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// Handle MsgRevealAuthority.
+func handleMsgRevealAuthority(ctx sdk.Context, keeper Keeper, msg types.MsgRevealAuthority) (*sdk.Result, error) {
+	name, err := keeper.ProcessRevealAuthority(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(name),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
 // Handle MsgSetName.
 func handleMsgSetName(ctx sdk.Context, keeper Keeper, msg types.MsgSetName) (*sdk.Result, error) {
 	err := keeper.ProcessSetName(ctx, msg)
@@ -161,6 +358,19 @@ func handleMsgSetName(ctx sdk.Context, keeper Keeper, msg types.MsgSetName) (*sd
 	}, nil
 }
 
+// Handle MsgSetAlias.
+func handleMsgSetAlias(ctx sdk.Context, keeper Keeper, msg types.MsgSetAlias) (*sdk.Result, error) {
+	err := keeper.ProcessSetAlias(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   []byte(msg.WRN),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
 // Handle MsgDeleteName.
 func handleMsgDeleteName(ctx sdk.Context, keeper Keeper, msg types.MsgDeleteName) (*sdk.Result, error) {
 	err := keeper.ProcessDeleteName(ctx, msg)
@@ -0,0 +1,196 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/vulcanize/dxns/x/nameservice/internal/helpers"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+// ApprovalExpiryTimeout => Pending approvals expire after this timeout (after the first approval).
+const ApprovalExpiryTimeout time.Duration = time.Hour * 24 * 7
+
+// Generates action hash -> Approval index key.
+func getApprovalIndexKey(action string) []byte {
+	return append(PrefixActionToApprovalIndex, []byte(action)...)
+}
+
+// Approvals are queued by expiry time, so that they can be pruned once expired.
+func getApprovalQueueKey(expiryTime time.Time, action string) []byte {
+	return append(getApprovalQueueTimeKey(expiryTime), []byte(action)...)
+}
+
+func getApprovalQueueTimeKey(expiryTime time.Time) []byte {
+	return append(append([]byte{}, PrefixExpiryTimeToApprovalsIndex...), sdk.FormatTimeBytes(expiryTime)...)
+}
+
+// GetActionHash returns the hash of an action, i.e. a message with the signer cleared.
+// Owners approve an action by sending identical messages, in the same or separate txs.
+func GetActionHash(action sdk.Msg) string {
+	hash := sha256.Sum256(types.ModuleCdc.MustMarshalJSON(action))
+	return hex.EncodeToString(hash[:])
+}
+
+// GetApproval - gets the (pending) approval for an action.
+func (k Keeper) GetApproval(ctx sdk.Context, action string) *types.Approval {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(getApprovalIndexKey(action))
+	if bz == nil {
+		return nil
+	}
+
+	var approval types.Approval
+	k.cdc.MustUnmarshalBinaryBare(bz, &approval)
+
+	return &approval
+}
+
+// ListApprovals - get all pending approvals.
+func (k Keeper) ListApprovals(ctx sdk.Context) []types.Approval {
+	var approvals []types.Approval
+
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, PrefixActionToApprovalIndex)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		bz := store.Get(itr.Key())
+		if bz != nil {
+			var approval types.Approval
+			k.cdc.MustUnmarshalBinaryBare(bz, &approval)
+			approvals = append(approvals, approval)
+		}
+	}
+
+	return approvals
+}
+
+// approve records the signer's approval for an action on an authority (empty for record actions).
+// Returns true once approved by (at least) threshold owners, at which point the action should be executed.
+func (k Keeper) approve(ctx sdk.Context, authority string, action sdk.Msg, owners []string, threshold int64, signer string) (bool, error) {
+	if !containsString(owners, signer) {
+		return false, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	if threshold <= 1 {
+		return true, nil
+	}
+
+	actionHash := GetActionHash(action)
+	approval := k.GetApproval(ctx, actionHash)
+
+	// Expired approvals (not pruned yet) don't count, approval starts over.
+	if approval != nil && !ctx.BlockTime().Before(approval.ExpiryTime) {
+		k.deleteApproval(ctx, *approval)
+		approval = nil
+	}
+
+	if approval == nil {
+		approval = &types.Approval{
+			Action:     actionHash,
+			Height:     ctx.BlockHeight(),
+			Authority:  authority,
+			ExpiryTime: ctx.BlockTime().Add(ApprovalExpiryTimeout),
+		}
+	}
+
+	if !containsString(approval.Signers, signer) {
+		approval.Signers = append(approval.Signers, signer)
+	}
+
+	// Only count approvals from current owners, as the owners may have changed since.
+	var count int64
+	for _, approvalSigner := range approval.Signers {
+		if containsString(owners, approvalSigner) {
+			count++
+		}
+	}
+
+	if count >= threshold {
+		k.deleteApproval(ctx, *approval)
+		return true, nil
+	}
+
+	k.setApproval(ctx, *approval)
+
+	return false, nil
+}
+
+func (k Keeper) setApproval(ctx sdk.Context, approval types.Approval) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(getApprovalIndexKey(approval.Action), k.cdc.MustMarshalBinaryBare(approval))
+	store.Set(getApprovalQueueKey(approval.ExpiryTime, approval.Action), []byte{})
+}
+
+func (k Keeper) deleteApproval(ctx sdk.Context, approval types.Approval) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getApprovalIndexKey(approval.Action))
+	store.Delete(getApprovalQueueKey(approval.ExpiryTime, approval.Action))
+}
+
+// deleteAuthorityApprovals discards the pending approvals for an authority's actions,
+// e.g. when the owner policy changes, as they were collected under the old policy.
+func (k Keeper) deleteAuthorityApprovals(ctx sdk.Context, name string) {
+	for _, approval := range k.ListApprovals(ctx) {
+		if approval.Authority == name {
+			k.deleteApproval(ctx, approval)
+		}
+	}
+}
+
+// ProcessApprovalExpiryQueue prunes approvals that weren't completed in time.
+func (k Keeper) ProcessApprovalExpiryQueue(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	itr := store.Iterator(PrefixExpiryTimeToApprovalsIndex, getApprovalQueueTimeKey(ctx.BlockTime()))
+
+	var expired [][]byte
+	for ; itr.Valid(); itr.Next() {
+		expired = append(expired, itr.Key())
+	}
+
+	itr.Close()
+
+	for _, key := range expired {
+		actionHash := string(key[len(getApprovalQueueTimeKey(time.Time{})):])
+		store.Delete(getApprovalIndexKey(actionHash))
+		store.Delete(key)
+
+		ctx.Logger().Info(fmt.Sprintf("Pruned expired approval: %s", actionHash))
+	}
+}
+
+// approveRecordAction records a record owner's approval for a record mutation.
+func (k Keeper) approveRecordAction(ctx sdk.Context, record types.Record, signer sdk.AccAddress, action sdk.Msg) (bool, error) {
+	return k.approve(ctx, "", action, record.Owners, record.OwnerThreshold, k.getRecordOwnerID(ctx, signer))
+}
+
+// getRecordOwnerID returns the record owner ID of an account, derived from the account public key
+// in the same way as the owners of a record (see helpers.GetAddressFromPubKey).
+// Returns an empty string if the account public key isn't known yet.
+func (k Keeper) getRecordOwnerID(ctx sdk.Context, address sdk.AccAddress) string {
+	account := k.accountKeeper.GetAccount(ctx, address)
+	if account == nil || account.GetPubKey() == nil {
+		return ""
+	}
+
+	return helpers.GetAddressFromPubKey(account.GetPubKey())
+}
+
+func containsString(values []string, value string) bool {
+	for _, entry := range values {
+		if entry == value {
+			return true
+		}
+	}
+
+	return false
+}
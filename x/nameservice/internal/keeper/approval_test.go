@@ -0,0 +1,75 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+func setTestAuthorityPolicy(input testInput, ctx sdk.Context, owners ...sdk.AccAddress) {
+	policy := types.OwnerPolicy{Threshold: 2}
+	for _, owner := range owners {
+		policy.Owners = append(policy.Owners, owner.String())
+	}
+
+	input.keeper.SetNameAuthority(ctx, "example", types.NameAuthority{OwnerPolicy: policy, Status: types.AuthorityActive})
+}
+
+func TestApprovalExpiry(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+	_, owner1 := input.createTestAccount(t, "1000000uwire")
+	_, owner2 := input.createTestAccount(t, "1000000uwire")
+	setTestAuthorityPolicy(input, ctx, owner1, owner2)
+
+	msg := types.NewMsgSetAuthorityPolicy("example", []string{owner1.String()}, 1, owner1)
+	require.NoError(t, k.ProcessSetAuthorityPolicy(ctx, msg))
+
+	approval := k.GetApproval(ctx, GetActionHash(types.NewMsgSetAuthorityPolicy("example", msg.Owners, msg.Threshold, nil)))
+	require.NotNil(t, approval)
+	require.Equal(t, "example", approval.Authority)
+	require.Equal(t, ctx.BlockTime().Add(ApprovalExpiryTimeout), approval.ExpiryTime)
+
+	// Expired approvals don't count, approval starts over.
+	ctx = ctx.WithBlockTime(approval.ExpiryTime)
+	msg.Signer = owner2
+	require.NoError(t, k.ProcessSetAuthorityPolicy(ctx, msg))
+	require.Equal(t, 2, len(k.GetNameAuthority(ctx, "example").OwnerPolicy.Owners))
+
+	restarted := k.GetApproval(ctx, approval.Action)
+	require.Equal(t, []string{owner2.String()}, restarted.Signers)
+
+	// Approvals not completed in time are pruned.
+	k.ProcessApprovalExpiryQueue(ctx.WithBlockTime(restarted.ExpiryTime))
+	require.NotNil(t, k.GetApproval(ctx, approval.Action))
+
+	k.ProcessApprovalExpiryQueue(ctx.WithBlockTime(restarted.ExpiryTime.Add(1)))
+	require.Nil(t, k.GetApproval(ctx, approval.Action))
+}
+
+func TestSetAuthorityPolicyDeletesPendingApprovals(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+	_, owner1 := input.createTestAccount(t, "1000000uwire")
+	_, owner2 := input.createTestAccount(t, "1000000uwire")
+	setTestAuthorityPolicy(input, ctx, owner1, owner2)
+
+	pending := types.NewMsgSetAuthorityPolicy("example", []string{owner1.String()}, 1, owner1)
+	require.NoError(t, k.ProcessSetAuthorityPolicy(ctx, pending))
+	require.Len(t, k.ListApprovals(ctx), 1)
+
+	for _, owner := range []sdk.AccAddress{owner1, owner2} {
+		msg := types.NewMsgSetAuthorityPolicy("example", []string{owner1.String(), owner2.String()}, 1, owner)
+		require.NoError(t, k.ProcessSetAuthorityPolicy(ctx, msg))
+	}
+
+	// Approvals collected under the old policy are discarded.
+	require.Equal(t, int64(1), k.GetNameAuthority(ctx, "example").OwnerPolicy.Threshold)
+	require.Empty(t, k.ListApprovals(ctx))
+}
@@ -0,0 +1,203 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	wnsUtils "github.com/vulcanize/dxns/utils"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+func getAuthorityCommitmentIndexKey(commitHash string) []byte {
+	return append(PrefixCommitHashToAuthorityCommitmentIndex, []byte(commitHash)...)
+}
+
+// Commitments are queued by commit time, so that they can be pruned once the reveal window is over.
+func getAuthorityCommitmentQueueKey(commitTime time.Time, commitHash string) []byte {
+	return append(getAuthorityCommitmentQueueTimeKey(commitTime), []byte(commitHash)...)
+}
+
+func getAuthorityCommitmentQueueTimeKey(commitTime time.Time) []byte {
+	return append(append([]byte{}, PrefixCommitTimeToAuthorityCommitmentsIndex...), sdk.FormatTimeBytes(commitTime)...)
+}
+
+// GetAuthorityCommitment - gets an authority commitment by commit hash.
+func (k Keeper) GetAuthorityCommitment(ctx sdk.Context, commitHash string) *types.AuthorityCommitment {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(getAuthorityCommitmentIndexKey(commitHash))
+	if bz == nil {
+		return nil
+	}
+
+	var commitment types.AuthorityCommitment
+	k.cdc.MustUnmarshalBinaryBare(bz, &commitment)
+
+	return &commitment
+}
+
+func (k Keeper) setAuthorityCommitment(ctx sdk.Context, commitment types.AuthorityCommitment) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(getAuthorityCommitmentIndexKey(commitment.CommitHash), k.cdc.MustMarshalBinaryBare(commitment))
+	store.Set(getAuthorityCommitmentQueueKey(commitment.CommitTime, commitment.CommitHash), []byte{})
+}
+
+func (k Keeper) deleteAuthorityCommitment(ctx sdk.Context, commitment types.AuthorityCommitment) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getAuthorityCommitmentIndexKey(commitment.CommitHash))
+	store.Delete(getAuthorityCommitmentQueueKey(commitment.CommitTime, commitment.CommitHash))
+}
+
+// ProcessCommitAuthority records a commitment to reserve a (hidden) root authority name.
+func (k Keeper) ProcessCommitAuthority(ctx sdk.Context, msg types.MsgCommitAuthority) error {
+	params := k.GetParams(ctx)
+	if !params.AuthorityCommitRevealEnabled {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Commit/reveal reservation is not enabled.")
+	}
+
+	// Commitments made now could never be revealed.
+	if err := checkRevealPeriod(params); err != nil {
+		return err
+	}
+
+	if k.GetAuthorityCommitment(ctx, msg.CommitHash) != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Commitment already exists.")
+	}
+
+	k.setAuthorityCommitment(ctx, types.AuthorityCommitment{
+		CommitHash: msg.CommitHash,
+		Signer:     msg.Signer.String(),
+		CommitTime: ctx.BlockTime(),
+	})
+
+	return nil
+}
+
+// ProcessRevealAuthority reserves the root authority committed to earlier.
+// The reveal uses the same (canonical JSON CID) hashing scheme as auction bids.
+func (k Keeper) ProcessRevealAuthority(ctx sdk.Context, msg types.MsgRevealAuthority) (string, error) {
+	params := k.GetParams(ctx)
+	if !params.AuthorityCommitRevealEnabled {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Commit/reveal reservation is not enabled.")
+	}
+
+	if err := checkRevealPeriod(params); err != nil {
+		return "", err
+	}
+
+	revealBytes, err := hex.DecodeString(msg.Reveal)
+	if err != nil {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid reveal string.")
+	}
+
+	commitHash, err := wnsUtils.CIDFromJSONBytes(revealBytes)
+	if err != nil {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid reveal JSON.")
+	}
+
+	commitment := k.GetAuthorityCommitment(ctx, commitHash)
+	if commitment == nil {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Commitment not found.")
+	}
+
+	if commitment.Signer != msg.Signer.String() {
+		return "", sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Commitment signer mismatch.")
+	}
+
+	// The delay stops a reveal from being front-run by a new commitment for the same name.
+	if ctx.BlockTime().Before(commitment.CommitTime.Add(params.AuthorityRevealDelay)) {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Reveal is too early.")
+	}
+
+	if ctx.BlockTime().After(commitment.CommitTime.Add(params.AuthorityRevealWindow)) {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Commitment expired.")
+	}
+
+	var reveal map[string]interface{}
+	err = json.Unmarshal(revealBytes, &reveal)
+	if err != nil {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Reveal JSON unmarshal error.")
+	}
+
+	chainID, err := wnsUtils.GetAttributeAsString(reveal, "chainId")
+	if err != nil || chainID != ctx.ChainID() {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid reveal chainID.")
+	}
+
+	ownerAddress, err := wnsUtils.GetAttributeAsString(reveal, "ownerAddress")
+	if err != nil || ownerAddress != msg.Signer.String() {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Reveal owner address mismatch.")
+	}
+
+	revealName, err := wnsUtils.GetAttributeAsString(reveal, "name")
+	if err != nil {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid reveal name.")
+	}
+
+	name, err := types.NormalizeName(revealName)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.Contains(name, ".") {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Only root authorities can be reserved using commit/reveal.")
+	}
+
+	k.deleteAuthorityCommitment(ctx, *commitment)
+
+	reservedFor, err := k.checkNameLists(ctx, name, msg.Signer)
+	if err != nil {
+		return "", err
+	}
+
+	// Reserved names are assigned directly, i.e. without an auction.
+	useAuction := reservedFor == ""
+	err = k.createAuthority(ctx, name, msg.Signer, useAuction)
+	if err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// checkRevealPeriod checks that the reveal delay is less than the reveal window. Genesis params are validated
+// as a whole, but param change proposals only validate the changed value.
+func checkRevealPeriod(params types.Params) error {
+	if params.AuthorityRevealDelay >= params.AuthorityRevealWindow {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Reveal delay must be less than the reveal window.")
+	}
+
+	return nil
+}
+
+// ProcessAuthorityCommitmentQueue prunes commitments that weren't revealed in time.
+func (k Keeper) ProcessAuthorityCommitmentQueue(ctx sdk.Context) {
+	cutoff := ctx.BlockTime().Add(-k.GetParams(ctx).AuthorityRevealWindow)
+
+	store := ctx.KVStore(k.storeKey)
+	itr := store.Iterator(PrefixCommitTimeToAuthorityCommitmentsIndex, getAuthorityCommitmentQueueTimeKey(cutoff))
+
+	var expired [][]byte
+	for ; itr.Valid(); itr.Next() {
+		expired = append(expired, itr.Key())
+	}
+
+	itr.Close()
+
+	for _, key := range expired {
+		commitHash := string(key[len(getAuthorityCommitmentQueueTimeKey(time.Time{})):])
+		store.Delete(getAuthorityCommitmentIndexKey(commitHash))
+		store.Delete(key)
+
+		ctx.Logger().Info(fmt.Sprintf("Pruned expired authority commitment: %s", commitHash))
+	}
+}
@@ -0,0 +1,123 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	wnsUtils "github.com/vulcanize/dxns/utils"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+// commitTestAuthority commits to reserving the name, and returns the (hex) reveal.
+func (input testInput) commitTestAuthority(t *testing.T, ctx sdk.Context, name string, signer sdk.AccAddress) string {
+	revealBytes, err := json.Marshal(map[string]interface{}{
+		"name":         name,
+		"ownerAddress": signer.String(),
+		"chainId":      ctx.ChainID(),
+		"salt":         "salt",
+	})
+	require.NoError(t, err)
+
+	commitHash, err := wnsUtils.CIDFromJSONBytes(revealBytes)
+	require.NoError(t, err)
+	require.NoError(t, input.keeper.ProcessCommitAuthority(ctx, types.NewMsgCommitAuthority(commitHash, signer)))
+
+	return hex.EncodeToString(revealBytes)
+}
+
+func setCommitRevealParams(ctx sdk.Context, k Keeper, revealDelay time.Duration, revealWindow time.Duration) types.Params {
+	params := types.DefaultParams()
+	params.AuthorityCommitRevealEnabled = true
+	params.AuthorityRevealDelay = revealDelay
+	params.AuthorityRevealWindow = revealWindow
+	k.SetParams(ctx, params)
+
+	return params
+}
+
+func TestProcessRevealAuthority(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+	_, owner := input.createTestAccount(t, "1000000000uwire")
+	params := setCommitRevealParams(ctx, k, time.Minute, time.Hour)
+
+	// Without a commitment, root names can't be reserved.
+	_, err := k.ProcessReserveAuthority(ctx, types.NewMsgReserveAuthority("example", owner, nil))
+	require.Error(t, err)
+
+	reveal := input.commitTestAuthority(t, ctx, "example", owner)
+
+	_, err = k.ProcessRevealAuthority(ctx, types.NewMsgRevealAuthority(reveal, owner))
+	require.Error(t, err, "reveal before the delay")
+
+	_, other := input.createTestAccount(t, "1000000000uwire")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.AuthorityRevealDelay))
+	_, err = k.ProcessRevealAuthority(ctx, types.NewMsgRevealAuthority(reveal, other))
+	require.Error(t, err, "reveal by another account")
+
+	name, err := k.ProcessRevealAuthority(ctx, types.NewMsgRevealAuthority(reveal, owner))
+	require.NoError(t, err)
+	require.Equal(t, "example", name)
+	require.Equal(t, owner.String(), k.GetNameAuthority(ctx, "example").OwnerAddress)
+
+	// Commitments can only be revealed once.
+	_, err = k.ProcessRevealAuthority(ctx, types.NewMsgRevealAuthority(reveal, owner))
+	require.Error(t, err)
+}
+
+func TestProcessRevealAuthorityAfterWindow(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+	_, owner := input.createTestAccount(t, "1000000000uwire")
+	params := setCommitRevealParams(ctx, k, time.Minute, time.Hour)
+
+	reveal := input.commitTestAuthority(t, ctx, "example", owner)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.AuthorityRevealWindow + time.Second))
+	_, err := k.ProcessRevealAuthority(ctx, types.NewMsgRevealAuthority(reveal, owner))
+	require.Error(t, err)
+	require.False(t, k.HasNameAuthority(ctx, "example"))
+}
+
+func TestProcessRevealAuthorityInvalidRevealPeriod(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+	_, owner := input.createTestAccount(t, "1000000000uwire")
+	setCommitRevealParams(ctx, k, time.Minute, time.Hour)
+
+	reveal := input.commitTestAuthority(t, ctx, "example", owner)
+
+	// Param change proposals validate params one at a time, so the delay can end up past the window.
+	params := setCommitRevealParams(ctx, k, time.Hour*2, time.Hour)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.AuthorityRevealDelay))
+
+	_, err := k.ProcessRevealAuthority(ctx, types.NewMsgRevealAuthority(reveal, owner))
+	require.Error(t, err)
+	require.Error(t, k.ProcessCommitAuthority(ctx, types.NewMsgCommitAuthority("commit-hash", owner)))
+}
+
+func TestProcessAuthorityCommitmentQueue(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+	_, owner := input.createTestAccount(t, "1000000000uwire")
+	params := setCommitRevealParams(ctx, k, time.Minute, time.Hour)
+
+	require.NoError(t, k.ProcessCommitAuthority(ctx, types.NewMsgCommitAuthority("old-commit", owner)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.AuthorityRevealWindow / 2))
+	require.NoError(t, k.ProcessCommitAuthority(ctx, types.NewMsgCommitAuthority("new-commit", owner)))
+
+	// Only commitments past the reveal window are pruned.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.AuthorityRevealWindow/2 + time.Second))
+	k.ProcessAuthorityCommitmentQueue(ctx)
+
+	require.Nil(t, k.GetAuthorityCommitment(ctx, "old-commit"))
+	require.NotNil(t, k.GetAuthorityCommitment(ctx, "new-commit"))
+}
@@ -0,0 +1,151 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/vulcanize/dxns/x/auction"
+	"github.com/vulcanize/dxns/x/bond"
+	"github.com/vulcanize/dxns/x/nameservice/internal/helpers"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+// testInput holds the keepers and context used by the keeper tests.
+type testInput struct {
+	ctx           sdk.Context
+	cdc           *codec.Codec
+	paramsKeeper  params.Keeper
+	accountKeeper auth.AccountKeeper
+	bankKeeper    bank.Keeper
+	bondKeeper    bond.Keeper
+	auctionKeeper auction.Keeper
+	keeper        Keeper
+}
+
+func makeTestCodec() *codec.Codec {
+	cdc := codec.New()
+
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	bond.RegisterCodec(cdc)
+	auction.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	return cdc
+}
+
+func createTestInput(t *testing.T) testInput {
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyAuth := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyBond := sdk.NewKVStoreKey(bond.StoreKey)
+	keyAuction := sdk.NewKVStoreKey(auction.StoreKey)
+	keyNameservice := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	for _, key := range []sdk.StoreKey{keyParams, keyAuth, keySupply, keyBond, keyAuction, keyNameservice} {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := makeTestCodec()
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain", Time: time.Now().UTC(), Height: 1}, false, log.NewNopLogger())
+
+	maccPerms := map[string][]string{
+		bond.ModuleName:                      nil,
+		auction.ModuleName:                   nil,
+		auction.AuctionBurnModuleAccountName: nil,
+		types.RecordRentModuleAccountName:    nil,
+		types.AuthorityRentModuleAccountName: nil,
+	}
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAuth, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), map[string]bool{})
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
+
+	auctionKeeper := auction.NewKeeper(accountKeeper, bankKeeper, supplyKeeper, nil, keyAuction, cdc, paramsKeeper.Subspace(auction.DefaultParamspace))
+	recordKeeper := NewRecordKeeper(auctionKeeper, keyNameservice, cdc)
+	bondKeeper := bond.NewKeeper(accountKeeper, bankKeeper, supplyKeeper, []bond.BondUsageKeeper{recordKeeper}, keyBond, cdc, paramsKeeper.Subspace(bond.DefaultParamspace))
+	keeper := NewKeeper(accountKeeper, supplyKeeper, recordKeeper, bondKeeper, auctionKeeper, keyNameservice, cdc, paramsKeeper.Subspace(types.DefaultParamspace))
+
+	accountKeeper.SetParams(ctx, auth.DefaultParams())
+	bankKeeper.SetSendEnabled(ctx, true)
+	bondKeeper.SetParams(ctx, bond.DefaultParams())
+	auctionKeeper.SetParams(ctx, auction.DefaultParams())
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	return testInput{
+		ctx:           ctx,
+		cdc:           cdc,
+		paramsKeeper:  paramsKeeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		bondKeeper:    bondKeeper,
+		auctionKeeper: auctionKeeper,
+		keeper:        keeper,
+	}
+}
+
+// createTestAccount creates a funded account, with its public key set (as after its first tx).
+func (input testInput) createTestAccount(t *testing.T, coins string) (crypto.PrivKey, sdk.AccAddress) {
+	privKey := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(privKey.PubKey().Address())
+
+	account := input.accountKeeper.NewAccountWithAddress(input.ctx, address)
+	require.NoError(t, account.SetPubKey(privKey.PubKey()))
+	input.accountKeeper.SetAccount(input.ctx, account)
+
+	amount, err := sdk.ParseCoins(coins)
+	require.NoError(t, err)
+	require.NoError(t, input.bankKeeper.SetCoins(input.ctx, address, amount))
+
+	return privKey, address
+}
+
+// createTestRecord publishes a record signed by the owner, paying the rent from a new bond.
+func (input testInput) createTestRecord(t *testing.T, owner crypto.PrivKey, address sdk.AccAddress, attributes map[string]interface{}) *types.Record {
+	bondObj, err := input.bondKeeper.CreateBond(input.ctx, address, sdk.NewCoins(sdk.NewInt64Coin("uwire", 100000000)))
+	require.NoError(t, err)
+
+	record := types.Record{Attributes: attributes}
+	signBytes, _ := record.GetSignBytes()
+	signature, err := owner.Sign(signBytes)
+	require.NoError(t, err)
+
+	payload := types.Payload{
+		Record: attributes,
+		Signatures: []types.Signature{{
+			PubKey:    helpers.BytesToBase64(owner.PubKey().Bytes()),
+			Signature: helpers.BytesToBase64(signature),
+		}},
+	}
+
+	created, err := input.keeper.ProcessSetRecord(input.ctx, types.NewMsgSetRecord(payload.ToPayloadObj(), string(bondObj.ID), address))
+	require.NoError(t, err)
+
+	return created
+}
@@ -0,0 +1,178 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+// HandleAuthorityDisputeProposal freezes, unfreezes, revokes or reassigns an authority and its sub-authorities.
+func (k Keeper) HandleAuthorityDisputeProposal(ctx sdk.Context, p types.AuthorityDisputeProposal) error {
+	authority := k.GetNameAuthority(ctx, p.Name)
+	if authority == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name authority not found.")
+	}
+
+	var newOwner sdk.AccAddress
+	if p.Action == types.DisputeActionReassign {
+		var err error
+		newOwner, err = sdk.AccAddressFromBech32(p.NewOwner)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid new owner address.")
+		}
+	}
+
+	for _, name := range k.getAuthorityTree(ctx, p.Name) {
+		authority := k.GetNameAuthority(ctx, name)
+
+		var changed bool
+		switch p.Action {
+		case types.DisputeActionFreeze:
+			changed = k.freezeAuthority(ctx, name, *authority)
+		case types.DisputeActionUnfreeze:
+			changed = k.unfreezeAuthority(ctx, name, *authority)
+		case types.DisputeActionRevoke:
+			changed = k.revokeAuthority(ctx, name, *authority)
+		case types.DisputeActionReassign:
+			changed = k.reassignAuthority(ctx, name, *authority, newOwner)
+		default:
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Invalid action: %s", p.Action))
+		}
+
+		// Authorities the action doesn't apply to (e.g. already expired) are left as is.
+		if !changed {
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAuthorityDispute,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyAuthority, name),
+				sdk.NewAttribute(types.AttributeKeyAction, p.Action),
+				sdk.NewAttribute(types.AttributeKeyNewOwner, p.NewOwner),
+			),
+		)
+
+		ctx.Logger().Info(fmt.Sprintf("Authority dispute resolved (%s): %s", p.Action, name))
+	}
+
+	return nil
+}
+
+// getAuthorityTree returns the authority name and the names of all its sub-authorities, in store (i.e. sorted) order.
+func (k Keeper) getAuthorityTree(ctx sdk.Context, name string) []string {
+	names := []string{name}
+
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, PrefixNameAuthorityRecordIndex)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		subName := string(itr.Key()[len(PrefixNameAuthorityRecordIndex):])
+		if strings.HasSuffix(subName, "."+name) {
+			names = append(names, subName)
+		}
+	}
+
+	return names
+}
+
+// freezeAuthority stops an authority from being used, renewed or expired until it's unfrozen.
+func (k Keeper) freezeAuthority(ctx sdk.Context, name string, authority types.NameAuthority) bool {
+	if authority.Status != types.AuthorityActive && authority.Status != types.AuthorityInRedemption {
+		return false
+	}
+
+	k.DeleteAuthorityExpiryQueue(ctx, name, authority)
+	authority.FrozenStatus = authority.Status
+	authority.Status = types.AuthorityFrozen
+	k.SetNameAuthority(ctx, name, authority)
+
+	return true
+}
+
+// unfreezeAuthority restores the status of a frozen authority, i.e. active (collecting rent if it expired
+// while frozen) or in redemption (released if the redemption period ended while frozen).
+func (k Keeper) unfreezeAuthority(ctx sdk.Context, name string, authority types.NameAuthority) bool {
+	if authority.Status != types.AuthorityFrozen {
+		return false
+	}
+
+	if authority.ExpiryTime.Before(ctx.BlockTime()) {
+		authority.ExpiryTime = ctx.BlockTime()
+	}
+
+	authority.Status = authority.FrozenStatus
+	if authority.Status == "" {
+		authority.Status = types.AuthorityActive
+	}
+
+	authority.FrozenStatus = ""
+	k.InsertAuthorityExpiryQueue(ctx, name, authority.ExpiryTime)
+	k.SetNameAuthority(ctx, name, authority)
+
+	return true
+}
+
+// revokeAuthority expires an authority immediately, skipping the redemption period. A pending name auction
+// is detached from the authority, so the auction winner doesn't get the revoked name.
+func (k Keeper) revokeAuthority(ctx sdk.Context, name string, authority types.NameAuthority) bool {
+	if authority.Status == types.AuthorityExpired {
+		return false
+	}
+
+	if authority.Status != types.AuthorityFrozen {
+		k.DeleteAuthorityExpiryQueue(ctx, name, authority)
+	}
+
+	if authority.BondID != "" {
+		k.RemoveBondToAuthorityIndexEntry(ctx, authority.BondID, name)
+		authority.BondID = ""
+	}
+
+	if authority.AuctionID != "" {
+		k.RemoveAuctionToAuthorityMapping(ctx, authority.AuctionID)
+		authority.AuctionID = ""
+	}
+
+	authority.PendingOwnerAddress = ""
+	authority.FrozenStatus = ""
+	authority.OwnerPolicy = types.OwnerPolicy{}
+	authority.SubAuthorityPolicy = types.SubAuthorityPolicy{}
+	deleteGrants(ctx.KVStore(k.storeKey), name)
+	k.deleteAuthorityApprovals(ctx, name)
+
+	authority.Status = types.AuthorityExpired
+	k.SetNameAuthority(ctx, name, authority)
+
+	return true
+}
+
+// reassignAuthority transfers an authority to a new owner, making names set by previous owners stale.
+func (k Keeper) reassignAuthority(ctx sdk.Context, name string, authority types.NameAuthority, newOwner sdk.AccAddress) bool {
+	if authority.Status == types.AuthorityExpired || authority.Status == types.AuthorityUnderAuction {
+		return false
+	}
+
+	if authority.Status != types.AuthorityFrozen {
+		k.DeleteAuthorityExpiryQueue(ctx, name, authority)
+	}
+
+	// New owner gets a grace period to set a bond.
+	authority.ExpiryTime = ctx.BlockTime().Add(k.GetParams(ctx).AuthorityGracePeriod)
+	k.InsertAuthorityExpiryQueue(ctx, name, authority.ExpiryTime)
+
+	authority.Status = types.AuthorityActive
+	authority.FrozenStatus = ""
+	authority.Height = ctx.BlockHeight()
+	k.transferAuthority(ctx, name, authority, newOwner)
+
+	return true
+}
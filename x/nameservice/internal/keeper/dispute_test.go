@@ -0,0 +1,93 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+func TestUnfreezeAuthorityRestoresStatus(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+
+	authority := types.NameAuthority{Status: types.AuthorityInRedemption, ExpiryTime: ctx.BlockTime().Add(time.Hour)}
+	k.SetNameAuthority(ctx, "example", authority)
+	k.InsertAuthorityExpiryQueue(ctx, "example", authority.ExpiryTime)
+
+	freeze := types.NewAuthorityDisputeProposal("Freeze", "Freeze", "example", types.DisputeActionFreeze, "")
+	require.NoError(t, k.HandleAuthorityDisputeProposal(ctx, freeze))
+	require.Equal(t, types.AuthorityFrozen, k.GetNameAuthority(ctx, "example").Status)
+
+	// Authority in redemption stays in redemption, i.e. the redemption penalty still applies.
+	unfreeze := types.NewAuthorityDisputeProposal("Unfreeze", "Unfreeze", "example", types.DisputeActionUnfreeze, "")
+	require.NoError(t, k.HandleAuthorityDisputeProposal(ctx, unfreeze))
+
+	unfrozen := k.GetNameAuthority(ctx, "example")
+	require.Equal(t, types.AuthorityInRedemption, unfrozen.Status)
+	require.Empty(t, unfrozen.FrozenStatus)
+	require.Equal(t, []string{"example"}, k.GetAuthorityExpiryQueueTimeSlice(ctx, authority.ExpiryTime))
+}
+
+func TestRevokeAuthorityUnderAuction(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+
+	authority := types.NameAuthority{Status: types.AuthorityUnderAuction, AuctionID: "auction1", ExpiryTime: ctx.BlockTime().Add(time.Hour)}
+	k.SetNameAuthority(ctx, "example", authority)
+	k.InsertAuthorityExpiryQueue(ctx, "example", authority.ExpiryTime)
+	k.AddAuctionToAuthorityMapping(ctx, "auction1", "example")
+
+	revoke := types.NewAuthorityDisputeProposal("Revoke", "Revoke", "example", types.DisputeActionRevoke, "")
+	require.NoError(t, k.HandleAuthorityDisputeProposal(ctx, revoke))
+
+	// Auction winner doesn't get the revoked authority.
+	revoked := k.GetNameAuthority(ctx, "example")
+	require.Equal(t, types.AuthorityExpired, revoked.Status)
+	require.Empty(t, revoked.AuctionID)
+	require.Empty(t, k.recordKeeper.GetAuctionToAuthorityMapping(ctx, "auction1"))
+	require.Empty(t, k.GetAuthorityExpiryQueueTimeSlice(ctx, authority.ExpiryTime))
+	require.Len(t, ctx.EventManager().Events(), 1)
+
+	// Revoking an expired authority is a no-op.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.HandleAuthorityDisputeProposal(ctx, revoke))
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestReassignAuthority(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+	_, owner := input.createTestAccount(t, "1000000uwire")
+	_, newOwner := input.createTestAccount(t, "1000000uwire")
+
+	authority := types.NameAuthority{OwnerAddress: owner.String(), Status: types.AuthorityActive, BondID: "bond1", ExpiryTime: ctx.BlockTime().Add(time.Hour)}
+	k.SetNameAuthority(ctx, "example", authority)
+	k.InsertAuthorityExpiryQueue(ctx, "example", authority.ExpiryTime)
+	k.AddBondToAuthorityIndexEntry(ctx, "bond1", "example")
+
+	underAuction := types.NameAuthority{Status: types.AuthorityUnderAuction, AuctionID: "auction1", ExpiryTime: ctx.BlockTime().Add(time.Hour)}
+	k.SetNameAuthority(ctx, "sub.example", underAuction)
+
+	reassign := types.NewAuthorityDisputeProposal("Reassign", "Reassign", "example", types.DisputeActionReassign, newOwner.String())
+	require.NoError(t, k.HandleAuthorityDisputeProposal(ctx, reassign))
+
+	// New owner gets a grace period to set a bond.
+	reassigned := k.GetNameAuthority(ctx, "example")
+	require.Equal(t, newOwner.String(), reassigned.OwnerAddress)
+	require.Equal(t, types.AuthorityActive, reassigned.Status)
+	require.Empty(t, reassigned.BondID)
+	require.Equal(t, ctx.BlockTime().Add(k.GetParams(ctx).AuthorityGracePeriod), reassigned.ExpiryTime)
+	require.Empty(t, k.GetAuthorityExpiryQueueTimeSlice(ctx, authority.ExpiryTime))
+	require.Equal(t, []string{"example"}, k.GetAuthorityExpiryQueueTimeSlice(ctx, reassigned.ExpiryTime))
+
+	// Authorities under auction are left to the auction.
+	require.Equal(t, underAuction, *k.GetNameAuthority(ctx, "sub.example"))
+	require.Len(t, ctx.EventManager().Events(), 1)
+}
@@ -0,0 +1,169 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+// Generates authority -> [Grant] index prefix.
+func getAuthorityGrantsIndexPrefix(name string) []byte {
+	return append(append(append([]byte{}, PrefixAuthorityToGrantsIndex...), []byte(name)...), 0x00)
+}
+
+// Generates authority + grantee -> [Grant] index prefix.
+func getGranteeGrantsIndexPrefix(name string, grantee string) []byte {
+	return append(append(getAuthorityGrantsIndexPrefix(name), []byte(grantee)...), 0x00)
+}
+
+// Generates authority + grantee + pattern -> Grant index key.
+func getGrantIndexKey(name string, grantee string, pattern string) []byte {
+	return append(getGranteeGrantsIndexPrefix(name, grantee), []byte(pattern)...)
+}
+
+// GetGrant - gets a grant from the store.
+func (k Keeper) GetGrant(ctx sdk.Context, name string, grantee string, pattern string) *types.Grant {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(getGrantIndexKey(name, grantee, pattern))
+	if bz == nil {
+		return nil
+	}
+
+	var grant types.Grant
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+
+	return &grant
+}
+
+// ListGrants - get all grants for an authority.
+func (k Keeper) ListGrants(ctx sdk.Context, name string) []types.Grant {
+	return k.getGrants(ctx, getAuthorityGrantsIndexPrefix(name))
+}
+
+func (k Keeper) getGrants(ctx sdk.Context, prefix []byte) []types.Grant {
+	var grants []types.Grant
+
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, prefix)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		bz := store.Get(itr.Key())
+		if bz != nil {
+			var grant types.Grant
+			k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+			grants = append(grants, grant)
+		}
+	}
+
+	return grants
+}
+
+// deleteGrants removes all grants for an authority, e.g. when the owner changes.
+func deleteGrants(store sdk.KVStore, name string) {
+	itr := sdk.KVStorePrefixIterator(store, getAuthorityGrantsIndexPrefix(name))
+
+	var keys [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+
+	itr.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// hasNameGrant checks if the signer has been granted the permission for the WRN.
+func (k Keeper) hasNameGrant(ctx sdk.Context, name string, signer sdk.AccAddress, wrn string, permission string) bool {
+	for _, grant := range k.getGrants(ctx, getGranteeGrantsIndexPrefix(name, signer.String())) {
+		if grant.Matches(wrn) && grant.Allows(permission, ctx.BlockTime()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ProcessGrantNameAccess grants (or updates) scoped write access to names under an authority.
+func (k Keeper) ProcessGrantNameAccess(ctx sdk.Context, msg types.MsgGrantNameAccess) error {
+	pattern, err := types.NormalizeWRN(msg.Pattern)
+	if err != nil {
+		return err
+	}
+
+	msg.Pattern = pattern
+
+	name, _, authority, err := k.getAuthority(ctx, msg.Pattern)
+	if err != nil {
+		return err
+	}
+
+	if !authority.OwnerPolicy.IsSet() && authority.OwnerAddress != msg.Signer.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	if authority.Status != types.AuthorityActive {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Authority is not active.")
+	}
+
+	action := types.NewMsgGrantNameAccess(msg.Pattern, msg.Grantee, msg.Permissions, msg.ExpiryTime, nil)
+	approved, err := k.approveAuthorityAction(ctx, name, *authority, msg.Signer, action)
+	if err != nil || !approved {
+		return err
+	}
+
+	grant := types.Grant{
+		Authority:   name,
+		Grantee:     msg.Grantee.String(),
+		Pattern:     msg.Pattern,
+		Permissions: msg.Permissions,
+		ExpiryTime:  msg.ExpiryTime,
+		Height:      ctx.BlockHeight(),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(getGrantIndexKey(name, grant.Grantee, grant.Pattern), k.cdc.MustMarshalBinaryBare(grant))
+
+	return nil
+}
+
+// ProcessRevokeNameAccess revokes a grant. Grantees can also give up their own grants.
+func (k Keeper) ProcessRevokeNameAccess(ctx sdk.Context, msg types.MsgRevokeNameAccess) error {
+	pattern, err := types.NormalizeWRN(msg.Pattern)
+	if err != nil {
+		return err
+	}
+
+	msg.Pattern = pattern
+
+	name, _, authority, err := k.getAuthority(ctx, msg.Pattern)
+	if err != nil {
+		return err
+	}
+
+	if k.GetGrant(ctx, name, msg.Grantee.String(), msg.Pattern) == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Grant not found.")
+	}
+
+	if !msg.Grantee.Equals(msg.Signer) {
+		if !authority.OwnerPolicy.IsSet() && authority.OwnerAddress != msg.Signer.String() {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+		}
+
+		approved, err := k.approveAuthorityAction(ctx, name, *authority, msg.Signer, types.NewMsgRevokeNameAccess(msg.Pattern, msg.Grantee, nil))
+		if err != nil || !approved {
+			return err
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getGrantIndexKey(name, msg.Grantee.String(), msg.Pattern))
+
+	return nil
+}
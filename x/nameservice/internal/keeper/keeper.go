@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/tendermint/go-amino"
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/auction"
 	"github.com/vulcanize/dxns/x/bond"
 	"github.com/vulcanize/dxns/x/nameservice/internal/types"
@@ -43,20 +44,58 @@ var PrefixAuctionToAuthorityNameIndex = []byte{0x05}
 // PrefixBondIDToAuthoritiesIndex is the prefix for the Bond ID -> [Authority] index.
 var PrefixBondIDToAuthoritiesIndex = []byte{0x06}
 
+// PrefixRecordTypeToSchemaIndex is the prefix for the record type -> RecordSchema index.
+var PrefixRecordTypeToSchemaIndex = []byte{0x07}
+
+// PrefixActionToApprovalIndex is the prefix for the action hash -> (pending) Approval index.
+var PrefixActionToApprovalIndex = []byte{0x08}
+
+// PrefixAuthorityToGrantsIndex is the prefix for the authority -> [Grant] index.
+var PrefixAuthorityToGrantsIndex = []byte{0x09}
+
+// PrefixBlockedNameIndex is the prefix for the (governance-managed) blocked name index.
+var PrefixBlockedNameIndex = []byte{0x0a}
+
+// PrefixReservedNameIndex is the prefix for the (governance-managed) reserved name -> address index.
+var PrefixReservedNameIndex = []byte{0x0b}
+
+// PrefixCommitHashToAuthorityCommitmentIndex is the prefix for the commit hash -> AuthorityCommitment index.
+var PrefixCommitHashToAuthorityCommitmentIndex = []byte{0x0c}
+
 // PrefixExpiryTimeToRecordsIndex is the prefix for the Expiry Time -> [Record] index.
 var PrefixExpiryTimeToRecordsIndex = []byte{0x10}
 
 // PrefixExpiryTimeToAuthoritiesIndex is the prefix for the Expiry Time -> [Authority] index.
 var PrefixExpiryTimeToAuthoritiesIndex = []byte{0x11}
 
+// PrefixCommitTimeToAuthorityCommitmentsIndex is the prefix for the Commit Time -> [AuthorityCommitment] index.
+var PrefixCommitTimeToAuthorityCommitmentsIndex = []byte{0x12}
+
+// PrefixExpiryTimeToApprovalsIndex is the prefix for the expiry time -> [pending Approval] index.
+var PrefixExpiryTimeToApprovalsIndex = []byte{0x13}
+
 // KeySyncStatus is the key for the sync status record.
 // Only used by WNS lite but defined here to prevent conflicts with existing prefixes.
 var KeySyncStatus = []byte{0xff}
 
+// KeySyncIndexVersion is the key for the version of the node-local record indexes.
+// Only used by WNS lite but defined here to prevent conflicts with existing prefixes.
+var KeySyncIndexVersion = []byte{0xfe}
+
 // PrefixCIDToNamesIndex the the reverse index for naming, i.e. maps CID -> []Names.
 // TODO(ashwin): Move out of WNS once we have an indexing service.
 var PrefixCIDToNamesIndex = []byte{0xe0}
 
+// PrefixAttributeToRecordsIndex is the prefix for the attribute (key, value) -> [Record] index.
+// Only maintained for IndexedAttributes, to avoid full store scans when querying records.
+var PrefixAttributeToRecordsIndex = []byte{0xe1}
+
+// PrefixRecordToReferencingRecordsIndex is the prefix for the record ID -> [referencing Record] index.
+var PrefixRecordToReferencingRecordsIndex = []byte{0xe2}
+
+// IndexedAttributes are the record attributes with a secondary (attribute -> [Record]) index.
+var IndexedAttributes = []string{"type", "name", "version"}
+
 // Keeper maintains the link to storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
 	accountKeeper auth.AccountKeeper
@@ -159,14 +198,14 @@ func GetRecord(store sdk.KVStore, codec *amino.Codec, id types.ID) types.Record
 	return recordObjToRecord(store, codec, obj)
 }
 
-// ListRecords - get all records.
-func (k Keeper) ListRecords(ctx sdk.Context) []types.Record {
+// ListRecords - get all records (page).
+func (k Keeper) ListRecords(ctx sdk.Context, pagination wnsUtils.Pagination) []types.Record {
 	var records []types.Record
 
 	store := ctx.KVStore(k.storeKey)
-	itr := sdk.KVStorePrefixIterator(store, PrefixCIDToRecordIndex)
+	itr := wnsUtils.PaginatedIterator(store, PrefixCIDToRecordIndex, pagination)
 	defer itr.Close()
-	for ; itr.Valid(); itr.Next() {
+	for ; itr.Valid() && !pagination.IsFull(len(records)); itr.Next() {
 		bz := store.Get(itr.Key())
 		if bz != nil {
 			var obj types.RecordObj
@@ -178,19 +217,121 @@ func (k Keeper) ListRecords(ctx sdk.Context) []types.Record {
 	return records
 }
 
-// MatchRecords - get all matching records.
-func (k Keeper) MatchRecords(ctx sdk.Context, matchFn func(*types.Record) bool) []*types.Record {
-	return MatchRecords(ctx.KVStore(k.storeKey), k.cdc, matchFn)
+// MatchRecords - get all matching records (page).
+func (k Keeper) MatchRecords(ctx sdk.Context, matchFn func(*types.Record) bool, pagination wnsUtils.Pagination) []*types.Record {
+	return MatchRecords(ctx.KVStore(k.storeKey), k.cdc, matchFn, pagination)
 }
 
-// MatchRecords - get all matching records.
-func MatchRecords(store sdk.KVStore, codec *amino.Codec, matchFn func(*types.Record) bool) []*types.Record {
+// MatchRecords - get all matching records (page).
+func MatchRecords(store sdk.KVStore, codec *amino.Codec, matchFn func(*types.Record) bool, pagination wnsUtils.Pagination) []*types.Record {
 	var records []*types.Record
 
+	itr := wnsUtils.PaginatedIterator(store, PrefixCIDToRecordIndex, pagination)
+	defer itr.Close()
+	for ; itr.Valid() && !pagination.IsFull(len(records)); itr.Next() {
+		bz := store.Get(itr.Key())
+		if bz != nil {
+			var obj types.RecordObj
+			codec.MustUnmarshalBinaryBare(bz, &obj)
+			record := recordObjToRecord(store, codec, obj)
+			if matchFn(&record) {
+				records = append(records, &record)
+			}
+		}
+	}
+
+	return records
+}
+
+// Generates attribute (key, value) -> [Record] index prefix.
+func getAttributeToRecordsIndexPrefix(key string, value string) []byte {
+	prefix := append(PrefixAttributeToRecordsIndex, []byte(key)...)
+	prefix = append(prefix, 0x00)
+	prefix = append(prefix, []byte(value)...)
+	return append(prefix, 0x00)
+}
+
+// Generates attribute (key, value) -> [Record] index key.
+func getAttributeToRecordsIndexKey(key string, value string, id types.ID) []byte {
+	return append(getAttributeToRecordsIndexPrefix(key, value), []byte(id)...)
+}
+
+// IsIndexedAttribute returns true if the attribute has a secondary index.
+func IsIndexedAttribute(key string) bool {
+	for _, indexedKey := range IndexedAttributes {
+		if key == indexedKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddRecordToAttributeIndexes adds the attribute -> [Record] index entries for a record.
+// Note: Record attributes are immutable, so entries never need to be updated.
+func AddRecordToAttributeIndexes(store sdk.KVStore, record types.Record) {
+	for _, key := range IndexedAttributes {
+		value, err := wnsUtils.GetAttributeAsString(record.Attributes, key)
+		if err != nil {
+			// Only string values are indexed.
+			continue
+		}
+
+		store.Set(getAttributeToRecordsIndexKey(key, value, record.ID), []byte{})
+	}
+}
+
+// ReindexRecordAttributes adds the attribute -> [Record] index entries for all records,
+// e.g. for records created before the attributes were indexed.
+func ReindexRecordAttributes(store sdk.KVStore, codec *amino.Codec) {
+	for _, record := range getAllRecords(store, codec) {
+		AddRecordToAttributeIndexes(store, record)
+	}
+}
+
+// ReindexRecordReferences adds the record ID -> [referencing Record] index entries for all records,
+// e.g. for records created before references were indexed.
+func ReindexRecordReferences(store sdk.KVStore, codec *amino.Codec) {
+	for _, record := range getAllRecords(store, codec) {
+		AddRecordToReferenceIndex(store, record)
+	}
+}
+
+// getAllRecords returns all records, read up front so that the caller can write to the store.
+func getAllRecords(store sdk.KVStore, codec *amino.Codec) []types.Record {
 	itr := sdk.KVStorePrefixIterator(store, PrefixCIDToRecordIndex)
 	defer itr.Close()
+
+	var records []types.Record
 	for ; itr.Valid(); itr.Next() {
-		bz := store.Get(itr.Key())
+		var obj types.RecordObj
+		codec.MustUnmarshalBinaryBare(itr.Value(), &obj)
+		records = append(records, obj.ToRecord())
+	}
+
+	return records
+}
+
+// AddRecordToAttributeIndexes adds the attribute -> [Record] index entries for a record.
+func (k Keeper) AddRecordToAttributeIndexes(ctx sdk.Context, record types.Record) {
+	AddRecordToAttributeIndexes(ctx.KVStore(k.storeKey), record)
+}
+
+// MatchIndexedRecords - get all matching records (page), from the records having the given (indexed) attribute value.
+func (k Keeper) MatchIndexedRecords(ctx sdk.Context, key string, value string, matchFn func(*types.Record) bool, pagination wnsUtils.Pagination) []*types.Record {
+	return MatchIndexedRecords(ctx.KVStore(k.storeKey), k.cdc, key, value, matchFn, pagination)
+}
+
+// MatchIndexedRecords - get all matching records (page), from the records having the given (indexed) attribute value.
+func MatchIndexedRecords(store sdk.KVStore, codec *amino.Codec, key string, value string, matchFn func(*types.Record) bool, pagination wnsUtils.Pagination) []*types.Record {
+	var records []*types.Record
+
+	indexPrefix := getAttributeToRecordsIndexPrefix(key, value)
+	itr := wnsUtils.PaginatedIterator(store, indexPrefix, pagination)
+	defer itr.Close()
+	for ; itr.Valid() && !pagination.IsFull(len(records)); itr.Next() {
+		cid := itr.Key()[len(indexPrefix):]
+		bz := store.Get(append(PrefixCIDToRecordIndex, cid...))
 		if bz != nil {
 			var obj types.RecordObj
 			codec.MustUnmarshalBinaryBare(bz, &obj)
@@ -204,6 +345,75 @@ func MatchRecords(store sdk.KVStore, codec *amino.Codec, matchFn func(*types.Rec
 	return records
 }
 
+// Generates record ID -> [referencing Record] index prefix.
+func getRecordToReferencingRecordsIndexPrefix(id types.ID) []byte {
+	prefix := append(PrefixRecordToReferencingRecordsIndex, []byte(id)...)
+	return append(prefix, 0x00)
+}
+
+// GetRecordReferences returns the IDs of the records referenced by (top-level) record attributes, i.e. `{"/": "<id>"}` values.
+func GetRecordReferences(record types.Record) []types.ID {
+	var ids []types.ID
+
+	for _, value := range record.Attributes {
+		if obj, ok := value.(map[string]interface{}); ok && len(obj) == 1 {
+			if id, ok := obj["/"].(string); ok {
+				ids = append(ids, types.ID(id))
+			}
+		}
+	}
+
+	return ids
+}
+
+// AddRecordToReferenceIndex adds the record ID -> [referencing Record] index entries for a record.
+// Note: Record attributes are immutable, so entries never need to be updated.
+func AddRecordToReferenceIndex(store sdk.KVStore, record types.Record) {
+	for _, id := range GetRecordReferences(record) {
+		store.Set(append(getRecordToReferencingRecordsIndexPrefix(id), []byte(record.ID)...), []byte{})
+	}
+}
+
+// AddRecordToReferenceIndex adds the record ID -> [referencing Record] index entries for a record.
+func (k Keeper) AddRecordToReferenceIndex(ctx sdk.Context, record types.Record) {
+	AddRecordToReferenceIndex(ctx.KVStore(k.storeKey), record)
+}
+
+// GetReferencingRecordIDs - get the IDs of the records referencing the given record.
+func GetReferencingRecordIDs(store sdk.KVStore, id types.ID) []types.ID {
+	var ids []types.ID
+
+	indexPrefix := getRecordToReferencingRecordsIndexPrefix(id)
+	itr := sdk.KVStorePrefixIterator(store, indexPrefix)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		ids = append(ids, types.ID(itr.Key()[len(indexPrefix):]))
+	}
+
+	return ids
+}
+
+// GetReferencingRecordIDs - get the IDs of the records referencing the given record.
+func (k Keeper) GetReferencingRecordIDs(ctx sdk.Context, id types.ID) []types.ID {
+	return GetReferencingRecordIDs(ctx.KVStore(k.storeKey), id)
+}
+
+// QueryRecordsReferencing - get all (live) records referencing the given record.
+func (k Keeper) QueryRecordsReferencing(ctx sdk.Context, id types.ID) []types.Record {
+	var records []types.Record
+
+	for _, referencingID := range k.GetReferencingRecordIDs(ctx, id) {
+		if k.HasRecord(ctx, referencingID) {
+			record := k.GetRecord(ctx, referencingID)
+			if !record.Deleted {
+				records = append(records, record)
+			}
+		}
+	}
+
+	return records
+}
+
 // QueryRecordsByBond - get all records for the given bond.
 func (k RecordKeeper) QueryRecordsByBond(ctx sdk.Context, bondID bond.ID) []types.Record {
 	var records []types.Record
@@ -289,6 +499,14 @@ func (k Keeper) DeleteRecordExpiryQueueTimeSlice(ctx sdk.Context, timestamp time
 // InsertRecordExpiryQueue inserts a record CID to the appropriate timeslice in the record expiry queue.
 func (k Keeper) InsertRecordExpiryQueue(ctx sdk.Context, val types.Record) {
 	timeSlice := k.GetRecordExpiryQueueTimeSlice(ctx, val.ExpiryTime)
+
+	// Don't add duplicate entries, e.g. on renewal.
+	for _, cid := range timeSlice {
+		if cid == val.ID {
+			return
+		}
+	}
+
 	timeSlice = append(timeSlice, val.ID)
 	k.SetRecordExpiryQueueTimeSlice(ctx, val.ExpiryTime, timeSlice)
 }
@@ -334,18 +552,19 @@ func (k Keeper) GetAllExpiredRecords(ctx sdk.Context, currTime time.Time) (expir
 }
 
 // ProcessRecordExpiryQueue tries to renew expiring records (by collecting rent) else marks them as deleted.
+// Records with auto-renewal turned off are deleted on expiry.
 func (k Keeper) ProcessRecordExpiryQueue(ctx sdk.Context) {
 	cids := k.GetAllExpiredRecords(ctx, ctx.BlockHeader().Time)
 	for _, cid := range cids {
 		record := k.GetRecord(ctx, cid)
 
-		// If record doesn't have an associated bond or if bond no longer exists, mark it deleted.
-		if record.BondID == "" || !k.bondKeeper.HasBond(ctx, record.BondID) {
+		// If record auto-renewal is off, record doesn't have an associated bond or if bond no longer exists, mark it deleted.
+		if record.DisableAutoRenew || record.BondID == "" || !k.bondKeeper.HasBond(ctx, record.BondID) {
 			record.Deleted = true
 			k.PutRecord(ctx, record)
 			k.DeleteRecordExpiryQueue(ctx, record)
 
-			return
+			continue
 		}
 
 		// Try to renew the record by taking rent.
@@ -388,8 +607,19 @@ func (k Keeper) TryTakeRecordRent(ctx sdk.Context, record types.Record) {
 	}
 
 	// Delete old expiry queue entry, create new one.
+	// Live records are extended from the previous expiry time, so there's no downtime.
+	// Deleted records (e.g. re-associated with a bond) are renewed from now.
 	k.DeleteRecordExpiryQueue(ctx, record)
-	record.ExpiryTime = ctx.BlockHeader().Time.Add(params.RecordRentDuration)
+	if record.Deleted {
+		record.ExpiryTime = ctx.BlockHeader().Time.Add(params.RecordRentDuration)
+		record.RentPaid = nil
+	} else {
+		record.ExpiryTime = record.ExpiryTime.Add(params.RecordRentDuration)
+	}
+
+	record.Rent = rent
+	record.RentDuration = params.RecordRentDuration
+	record.RentPaid = record.RentPaid.Add(rent...)
 	k.InsertRecordExpiryQueue(ctx, record)
 
 	// Save record.
@@ -0,0 +1,148 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+// MigrateNames moves authorities and name records stored under non-canonical names (i.e. registered
+// before names were normalized) to their canonical names. Entries with invalid names, or that clash
+// with an existing canonical entry, are dropped.
+func (k Keeper) MigrateNames(ctx sdk.Context) {
+	for _, name := range k.listKeys(ctx, PrefixNameAuthorityRecordIndex) {
+		canonicalName, err := types.NormalizeName(name)
+		if err == nil && canonicalName == name {
+			continue
+		}
+
+		authority := k.GetNameAuthority(ctx, name)
+		k.removeNameAuthority(ctx, name, *authority)
+
+		if err != nil || k.HasNameAuthority(ctx, canonicalName) {
+			ctx.Logger().Info(fmt.Sprintf("Dropped non-canonical authority: %s", name))
+			continue
+		}
+
+		k.SetNameAuthority(ctx, canonicalName, *authority)
+		k.InsertAuthorityExpiryQueue(ctx, canonicalName, authority.ExpiryTime)
+
+		if authority.BondID != "" {
+			k.AddBondToAuthorityIndexEntry(ctx, authority.BondID, canonicalName)
+		}
+
+		if authority.AuctionID != "" {
+			k.AddAuctionToAuthorityMapping(ctx, authority.AuctionID, canonicalName)
+		}
+
+		ctx.Logger().Info(fmt.Sprintf("Migrated authority %s to %s", name, canonicalName))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, wrn := range k.listKeys(ctx, PrefixWRNToNameRecordIndex) {
+		canonicalWRN, err := types.NormalizeWRN(wrn)
+		if err == nil && canonicalWRN == wrn {
+			continue
+		}
+
+		nameRecord := GetNameRecord(store, k.cdc, wrn)
+		store.Delete(GetNameRecordIndexKey(wrn))
+		if nameRecord.ID != "" {
+			RemoveRecordToNameMapping(store, k.cdc, nameRecord.ID, wrn)
+		}
+
+		if err != nil || store.Has(GetNameRecordIndexKey(canonicalWRN)) {
+			ctx.Logger().Info(fmt.Sprintf("Dropped non-canonical name: %s", wrn))
+			continue
+		}
+
+		if alias, err := types.NormalizeWRN(nameRecord.Alias); err == nil {
+			nameRecord.Alias = alias
+		}
+
+		store.Set(GetNameRecordIndexKey(canonicalWRN), k.cdc.MustMarshalBinaryBare(*nameRecord))
+		if nameRecord.ID != "" {
+			AddRecordToNameMapping(store, k.cdc, nameRecord.ID, canonicalWRN)
+		}
+
+		k.updateBlockChangesetForName(ctx, canonicalWRN)
+
+		ctx.Logger().Info(fmt.Sprintf("Migrated name %s to %s", wrn, canonicalWRN))
+	}
+}
+
+// MigrateAttributeIndexes adds the attribute -> [Record] index entries for records created before
+// the attributes were indexed.
+func (k Keeper) MigrateAttributeIndexes(ctx sdk.Context) {
+	ReindexRecordAttributes(ctx.KVStore(k.storeKey), k.cdc)
+}
+
+// MigrateReferenceIndexes adds the record ID -> [referencing Record] index entries for records created
+// before references were indexed.
+func (k Keeper) MigrateReferenceIndexes(ctx sdk.Context) {
+	ReindexRecordReferences(ctx.KVStore(k.storeKey), k.cdc)
+}
+
+// MigrateRecordSchemas drops the schemas registered for record types outside the publishing authority
+// (i.e. before record types were scoped to authorities), which would otherwise apply to everyone's records.
+func (k Keeper) MigrateRecordSchemas(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, schema := range k.ListRecordSchemas(ctx) {
+		if IsRecordTypeInAuthority(schema.Type, schema.Authority) {
+			continue
+		}
+
+		store.Delete(getRecordSchemaIndexKey(schema.Type))
+		ctx.Logger().Info(fmt.Sprintf("Dropped record schema outside authority %s: %s", schema.Authority, schema.Type))
+	}
+}
+
+// MigrateApprovals sets an expiry time on the approvals pending from before approvals expired,
+// so that they're pruned if not completed in time.
+func (k Keeper) MigrateApprovals(ctx sdk.Context) {
+	for _, approval := range k.ListApprovals(ctx) {
+		if !approval.ExpiryTime.IsZero() {
+			continue
+		}
+
+		approval.ExpiryTime = ctx.BlockTime().Add(ApprovalExpiryTimeout)
+		k.setApproval(ctx, approval)
+	}
+}
+
+// removeNameAuthority deletes an authority and its indexes.
+func (k Keeper) removeNameAuthority(ctx sdk.Context, name string, authority types.NameAuthority) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(GetNameAuthorityIndexKey(name))
+	k.DeleteAuthorityExpiryQueue(ctx, name, authority)
+
+	if authority.BondID != "" {
+		RemoveBondToAuthorityIndexEntry(store, authority.BondID, name)
+	}
+
+	if authority.AuctionID != "" {
+		removeAuctionToAuthorityMapping(store, authority.AuctionID)
+	}
+
+	deleteGrants(store, name)
+}
+
+// listKeys returns the keys (without the prefix) under a prefix, in store order.
+func (k Keeper) listKeys(ctx sdk.Context, prefix []byte) []string {
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, prefix)
+	defer itr.Close()
+
+	var keys []string
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()[len(prefix):]))
+	}
+
+	return keys
+}
@@ -0,0 +1,107 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	wnsUtils "github.com/vulcanize/dxns/utils"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+func TestMigrateNames(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+	store := ctx.KVStore(k.storeKey)
+
+	authority := types.NameAuthority{Status: types.AuthorityActive, ExpiryTime: ctx.BlockTime()}
+
+	// Registered before names were normalized.
+	k.SetNameAuthority(ctx, "Example", authority)
+	k.InsertAuthorityExpiryQueue(ctx, "Example", authority.ExpiryTime)
+	SetNameRecord(store, k.cdc, "wrn://Example/app", "record-1", 1)
+
+	// Clashes with an existing canonical authority and name.
+	k.SetNameAuthority(ctx, "taken", authority)
+	k.SetNameAuthority(ctx, "TAKEN", authority)
+	SetNameRecord(store, k.cdc, "wrn://taken/app", "record-2", 1)
+	SetNameRecord(store, k.cdc, "wrn://TAKEN/app", "record-3", 1)
+
+	k.MigrateNames(ctx)
+
+	require.Nil(t, k.GetNameAuthority(ctx, "Example"))
+	require.NotNil(t, k.GetNameAuthority(ctx, "example"))
+	require.Equal(t, []string{"example"}, k.GetAuthorityExpiryQueueTimeSlice(ctx, authority.ExpiryTime))
+	require.Nil(t, GetNameRecord(store, k.cdc, "wrn://Example/app"))
+	require.Equal(t, types.ID("record-1"), GetNameRecord(store, k.cdc, "wrn://example/app").ID)
+
+	require.Nil(t, k.GetNameAuthority(ctx, "TAKEN"))
+	require.NotNil(t, k.GetNameAuthority(ctx, "taken"))
+	require.Nil(t, GetNameRecord(store, k.cdc, "wrn://TAKEN/app"))
+	require.Equal(t, types.ID("record-2"), GetNameRecord(store, k.cdc, "wrn://taken/app").ID)
+}
+
+func TestMigrateAttributeIndexes(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+
+	// Created before attributes were indexed.
+	record := types.Record{ID: "record-1", Attributes: map[string]interface{}{"type": "test", "name": "legacy"}}
+	k.PutRecord(ctx, record)
+
+	matchAll := func(*types.Record) bool { return true }
+	require.Empty(t, k.MatchIndexedRecords(ctx, "name", "legacy", matchAll, wnsUtils.Pagination{}))
+
+	k.MigrateAttributeIndexes(ctx)
+
+	records := k.MatchIndexedRecords(ctx, "name", "legacy", matchAll, wnsUtils.Pagination{})
+	require.Len(t, records, 1)
+	require.Equal(t, record.ID, records[0].ID)
+}
+
+func TestMigrateReferenceIndexes(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+
+	// Created before references were indexed.
+	record := types.Record{ID: "record-2", Attributes: map[string]interface{}{"type": "test", "parent": map[string]interface{}{"/": "record-1"}}}
+	k.PutRecord(ctx, record)
+	require.Empty(t, k.GetReferencingRecordIDs(ctx, "record-1"))
+
+	k.MigrateReferenceIndexes(ctx)
+
+	require.Equal(t, []types.ID{record.ID}, k.GetReferencingRecordIDs(ctx, "record-1"))
+}
+
+func TestMigrateRecordSchemas(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+
+	scoped := types.RecordSchema{Type: "wrn://acme/app", Authority: "acme", Schema: testSchema}
+	k.SetRecordSchema(ctx, scoped)
+	k.SetRecordSchema(ctx, types.RecordSchema{Type: "wrn:app", Authority: "acme", Schema: testSchema})
+
+	k.MigrateRecordSchemas(ctx)
+
+	require.Equal(t, []types.RecordSchema{scoped}, k.ListRecordSchemas(ctx))
+}
+
+func TestMigrateApprovals(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+
+	// Pending from before approvals expired.
+	legacy := types.Approval{Action: "action-1", Signers: []string{"owner-1"}, Height: 1}
+	ctx.KVStore(k.storeKey).Set(getApprovalIndexKey(legacy.Action), k.cdc.MustMarshalBinaryBare(legacy))
+
+	k.MigrateApprovals(ctx)
+
+	expiryTime := ctx.BlockTime().Add(ApprovalExpiryTimeout)
+	require.Equal(t, expiryTime, k.GetApproval(ctx, legacy.Action).ExpiryTime)
+
+	k.ProcessApprovalExpiryQueue(ctx.WithBlockTime(expiryTime.Add(1)))
+	require.Nil(t, k.GetApproval(ctx, legacy.Action))
+}
@@ -0,0 +1,114 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+func getBlockedNameIndexKey(name string) []byte {
+	return append(PrefixBlockedNameIndex, []byte(name)...)
+}
+
+func getReservedNameIndexKey(name string) []byte {
+	return append(PrefixReservedNameIndex, []byte(name)...)
+}
+
+// IsNameBlocked - checks if a name is on the blocked list.
+func (k Keeper) IsNameBlocked(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(getBlockedNameIndexKey(name))
+}
+
+// SetNameBlocked - adds a name to (or removes it from) the blocked list.
+func (k Keeper) SetNameBlocked(ctx sdk.Context, name string, blocked bool) {
+	store := ctx.KVStore(k.storeKey)
+	if blocked {
+		store.Set(getBlockedNameIndexKey(name), []byte{})
+	} else {
+		store.Delete(getBlockedNameIndexKey(name))
+	}
+}
+
+// ListBlockedNames - get all blocked names.
+func (k Keeper) ListBlockedNames(ctx sdk.Context) []string {
+	var names []string
+
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, PrefixBlockedNameIndex)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		names = append(names, string(itr.Key()[len(PrefixBlockedNameIndex):]))
+	}
+
+	return names
+}
+
+// GetReservedNameAddress - gets the address a name is reserved for, if any.
+func (k Keeper) GetReservedNameAddress(ctx sdk.Context, name string) string {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(getReservedNameIndexKey(name))
+	if bz == nil {
+		return ""
+	}
+
+	return string(bz)
+}
+
+// SetReservedName - reserves a name for an address.
+func (k Keeper) SetReservedName(ctx sdk.Context, reserved types.ReservedName) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(getReservedNameIndexKey(reserved.Name), []byte(reserved.Address))
+}
+
+// RemoveReservedName - removes a name from the reserved list.
+func (k Keeper) RemoveReservedName(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(getReservedNameIndexKey(name))
+}
+
+// ListReservedNames - get all reserved names.
+func (k Keeper) ListReservedNames(ctx sdk.Context) []types.ReservedName {
+	var names []types.ReservedName
+
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, PrefixReservedNameIndex)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		names = append(names, types.ReservedName{
+			Name:    string(itr.Key()[len(PrefixReservedNameIndex):]),
+			Address: string(itr.Value()),
+		})
+	}
+
+	return names
+}
+
+// HandleNameListChangeProposal applies a (passed) name list change proposal.
+func (k Keeper) HandleNameListChangeProposal(ctx sdk.Context, proposal types.NameListChangeProposal) error {
+	for _, name := range proposal.Unblock {
+		k.SetNameBlocked(ctx, name, false)
+	}
+
+	for _, name := range proposal.Block {
+		k.SetNameBlocked(ctx, name, true)
+	}
+
+	for _, name := range proposal.Unreserve {
+		k.RemoveReservedName(ctx, name)
+	}
+
+	for _, reserved := range proposal.Reserve {
+		k.SetReservedName(ctx, reserved)
+	}
+
+	ctx.Logger().Info(fmt.Sprintf("Name lists updated by proposal: %s", proposal.Title))
+
+	return nil
+}
@@ -116,6 +116,11 @@ func RemoveRecordToNameMapping(store sdk.KVStore, codec *amino.Codec, id types.I
 
 // SetNameRecord - sets a name record.
 func SetNameRecord(store sdk.KVStore, codec *amino.Codec, wrn string, id types.ID, height int64) {
+	SetNameRecordEntry(store, codec, wrn, types.NameRecordEntry{ID: id, Height: height})
+}
+
+// SetNameRecordEntry - sets the latest entry of a name record, i.e. a record ID or an alias.
+func SetNameRecordEntry(store sdk.KVStore, codec *amino.Codec, wrn string, entry types.NameRecordEntry) {
 	nameRecordIndexKey := GetNameRecordIndexKey(wrn)
 
 	var nameRecord types.NameRecord
@@ -130,16 +135,13 @@ func SetNameRecord(store sdk.KVStore, codec *amino.Codec, wrn string, id types.I
 		}
 	}
 
-	nameRecord.NameRecordEntry = types.NameRecordEntry{
-		ID:     id,
-		Height: height,
-	}
+	nameRecord.NameRecordEntry = entry
 
 	store.Set(nameRecordIndexKey, codec.MustMarshalBinaryBare(nameRecord))
 
 	// Update new CID -> []Name index.
-	if id != "" {
-		AddRecordToNameMapping(store, codec, id, wrn)
+	if entry.ID != "" {
+		AddRecordToNameMapping(store, codec, entry.ID, wrn)
 	}
 }
 
@@ -151,8 +153,56 @@ func (k Keeper) SetNameRecord(ctx sdk.Context, wrn string, id types.ID) {
 	k.updateBlockChangesetForName(ctx, wrn)
 }
 
+// SetNameAlias - points a name at another WRN.
+func (k Keeper) SetNameAlias(ctx sdk.Context, wrn string, target string) {
+	SetNameRecordEntry(ctx.KVStore(k.storeKey), k.cdc, wrn, types.NameRecordEntry{Alias: target, Height: ctx.BlockHeight()})
+
+	// Update changeset for name.
+	k.updateBlockChangesetForName(ctx, wrn)
+}
+
+// GetAliasChain returns the WRNs followed when resolving a name, starting with the name itself.
+// Fails if the aliases loop, or if there are more than MaxAliasDepth of them.
+func GetAliasChain(store sdk.KVStore, codec *amino.Codec, wrn string) ([]string, error) {
+	chain := []string{wrn}
+	seen := map[string]bool{wrn: true}
+
+	for {
+		nameRecord := GetNameRecord(store, codec, chain[len(chain)-1])
+		if nameRecord == nil || nameRecord.Alias == "" {
+			return chain, nil
+		}
+
+		if seen[nameRecord.Alias] {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Alias loop.")
+		}
+
+		if len(chain) > types.MaxAliasDepth {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Alias chain is too long.")
+		}
+
+		seen[nameRecord.Alias] = true
+		chain = append(chain, nameRecord.Alias)
+	}
+}
+
+// GetAliasChain returns the WRNs followed when resolving a name, starting with the name itself.
+func (k Keeper) GetAliasChain(ctx sdk.Context, wrn string) ([]string, error) {
+	wrn, err := types.NormalizeWRN(wrn)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetAliasChain(ctx.KVStore(k.storeKey), k.cdc, wrn)
+}
+
 // HasNameRecord - checks if a name record exists.
 func (k Keeper) HasNameRecord(ctx sdk.Context, wrn string) bool {
+	wrn, err := types.NormalizeWRN(wrn)
+	if err != nil {
+		return false
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(GetNameRecordIndexKey(wrn))
 }
@@ -173,6 +223,11 @@ func GetNameRecord(store sdk.KVStore, codec *amino.Codec, wrn string) *types.Nam
 
 // GetNameRecord - gets a name record from the store.
 func (k Keeper) GetNameRecord(ctx sdk.Context, wrn string) *types.NameRecord {
+	wrn, err := types.NormalizeWRN(wrn)
+	if err != nil {
+		return nil
+	}
+
 	_, _, authority, err := k.getAuthority(ctx, wrn)
 	if err != nil || authority.Status != types.AuthorityActive {
 		// If authority is not active (or any other error), lookup fails.
@@ -214,14 +269,14 @@ func (k Keeper) ListNameAuthorityRecords(ctx sdk.Context) map[string]types.NameA
 	return nameAuthorityRecords
 }
 
-// ListNameRecords - get all name records.
-func (k Keeper) ListNameRecords(ctx sdk.Context) map[string]types.NameRecord {
+// ListNameRecords - get all name records (page).
+func (k Keeper) ListNameRecords(ctx sdk.Context, pagination wnsUtils.Pagination) map[string]types.NameRecord {
 	nameRecords := make(map[string]types.NameRecord)
 
 	store := ctx.KVStore(k.storeKey)
-	itr := sdk.KVStorePrefixIterator(store, PrefixWRNToNameRecordIndex)
+	itr := wnsUtils.PaginatedIterator(store, PrefixWRNToNameRecordIndex, pagination)
 	defer itr.Close()
-	for ; itr.Valid(); itr.Next() {
+	for ; itr.Valid() && !pagination.IsFull(len(nameRecords)); itr.Next() {
 		bz := store.Get(itr.Key())
 		if bz != nil {
 			var record types.NameRecord
@@ -235,7 +290,32 @@ func (k Keeper) ListNameRecords(ctx sdk.Context) map[string]types.NameRecord {
 
 // ResolveWRN resolves a WRN to a record.
 func (k Keeper) ResolveWRN(ctx sdk.Context, wrn string) *types.Record {
-	_, _, authority, err := k.getAuthority(ctx, wrn)
+	wrn, err := types.NormalizeWRN(wrn)
+	if err != nil {
+		return nil
+	}
+
+	// Follow aliases, the version suffix (if any) applies to the target.
+	baseWRN, versionRange, found := types.ParseVersionedWRN(wrn)
+	chain, err := GetAliasChain(ctx.KVStore(k.storeKey), k.cdc, baseWRN)
+	if err != nil {
+		return nil
+	}
+
+	// Aliases must not be stale or under inactive authorities either.
+	for _, alias := range chain[:len(chain)-1] {
+		if k.GetNameRecord(ctx, alias) == nil {
+			return nil
+		}
+	}
+
+	baseWRN = chain[len(chain)-1]
+	wrn = baseWRN
+	if found {
+		wrn = baseWRN + types.VersionSeparator + versionRange
+	}
+
+	_, _, authority, err := k.getAuthority(ctx, baseWRN)
 	if err != nil || authority.Status != types.AuthorityActive {
 		// If authority is not active (or any other error), resolution fails.
 		return nil
@@ -244,7 +324,7 @@ func (k Keeper) ResolveWRN(ctx sdk.Context, wrn string) *types.Record {
 	// Name should not resolve if it's stale.
 	// i.e. authority was registered later than the name.
 	record, nameRecord := ResolveWRN(ctx.KVStore(k.storeKey), k.cdc, wrn)
-	if authority.Height > nameRecord.Height {
+	if nameRecord != nil && authority.Height > nameRecord.Height {
 		return nil
 	}
 
@@ -252,7 +332,26 @@ func (k Keeper) ResolveWRN(ctx sdk.Context, wrn string) *types.Record {
 }
 
 // ResolveWRN resolves a WRN to a record.
+// A version (range) suffix, e.g. wrn://authority/app@^1.2.0 or wrn://authority/app@latest,
+// resolves to the record with the highest matching version.
 func ResolveWRN(store sdk.KVStore, codec *amino.Codec, wrn string) (*types.Record, *types.NameRecord) {
+	baseWRN, versionRange, found := types.ParseVersionedWRN(wrn)
+
+	// Aliases are followed to the target WRN.
+	chain, err := GetAliasChain(store, codec, baseWRN)
+	if err != nil {
+		return nil, nil
+	}
+
+	if len(chain) > 1 {
+		wrn = chain[len(chain)-1]
+		baseWRN = wrn
+	}
+
+	if found {
+		return resolveVersionedWRN(store, codec, baseWRN, versionRange)
+	}
+
 	nameKey := GetNameRecordIndexKey(wrn)
 
 	if store.Has(nameKey) {
@@ -343,24 +442,39 @@ func (k RecordKeeper) OnAuctionWinnerSelected(ctx sdk.Context, auctionID auction
 
 // ProcessReserveAuthority reserves a name authority.
 func (k Keeper) ProcessReserveAuthority(ctx sdk.Context, msg types.MsgReserveAuthority) (string, error) {
-	wrn := fmt.Sprintf("wrn://%s", msg.Name)
-
-	parsedWRN, err := url.Parse(wrn)
+	// Names are stored in their canonical (lowercase, punycode) form.
+	name, err := types.NormalizeName(msg.Name)
 	if err != nil {
-		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid name.")
+		return "", err
 	}
 
-	name := parsedWRN.Host
-	if fmt.Sprintf("wrn://%s", name) != wrn {
-		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid name.")
+	isSubAuthority := strings.Contains(name, ".")
+
+	owner := msg.Signer
+	if isSubAuthority && !msg.Owner.Empty() {
+		owner = msg.Owner
+	}
+
+	reservedFor, err := k.checkNameLists(ctx, name, owner)
+	if err != nil {
+		return "", err
 	}
 
-	if strings.Contains(name, ".") {
+	if isSubAuthority {
 		return k.ProcessReserveSubAuthority(ctx, name, msg)
 	}
 
+	// Without auctions, root names are first-come-first-served, so (if enabled) they have to be
+	// reserved using commit/reveal to stop the name being front-run from the mempool.
+	params := k.GetParams(ctx)
+	if params.AuthorityCommitRevealEnabled && !params.AuthorityAuctionEnabled && reservedFor == "" {
+		return "", sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Name must be reserved using commit/reveal.")
+	}
+
 	// Reserve name with signer as owner.
-	sdkErr := k.createAuthority(ctx, name, msg.Signer, true)
+	// Reserved names are assigned directly, i.e. without an auction.
+	useAuction := reservedFor == ""
+	sdkErr := k.createAuthority(ctx, name, msg.Signer, useAuction)
 	if sdkErr != nil {
 		return "", sdkErr
 	}
@@ -368,9 +482,29 @@ func (k Keeper) ProcessReserveAuthority(ctx sdk.Context, msg types.MsgReserveAut
 	return name, nil
 }
 
+// checkNameLists checks the blocked and reserved name lists.
+// Returns the address the name is reserved for, if any.
+func (k Keeper) checkNameLists(ctx sdk.Context, name string, owner sdk.AccAddress) (string, error) {
+	if k.IsNameBlocked(ctx, name) {
+		return "", sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Name is blocked.")
+	}
+
+	// Reserved names can only be assigned to the designated address.
+	reservedFor := k.GetReservedNameAddress(ctx, name)
+	if reservedFor != "" && owner.String() != reservedFor {
+		return "", sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Name is reserved.")
+	}
+
+	return reservedFor, nil
+}
+
 // ProcessSetAuthorityBond sets a bond on an authority.
 func (k Keeper) ProcessSetAuthorityBond(ctx sdk.Context, msg types.MsgSetAuthorityBond) (string, error) {
-	name := msg.Name
+	name, err := types.NormalizeName(msg.Name)
+	if err != nil {
+		return "", err
+	}
+
 	signer := msg.Signer
 
 	authority := k.GetNameAuthority(ctx, name)
@@ -458,12 +592,18 @@ func (k RecordKeeper) GetAuctionToAuthorityMapping(ctx sdk.Context, auctionID au
 	return ""
 }
 
-func (k Keeper) createAuthority(ctx sdk.Context, name string, owner sdk.AccAddress, isRoot bool) error {
+// createAuthority reserves an authority for the owner. If useAuction is set (i.e. for root authorities that
+// aren't reserved for an address) and name auctions are enabled, the owner is picked by an auction instead.
+func (k Keeper) createAuthority(ctx sdk.Context, name string, owner sdk.AccAddress, useAuction bool) error {
 	moduleParams := k.GetParams(ctx)
 
 	// Authorities can be re-registered if they have expired.
 	if k.HasNameAuthority(ctx, name) {
 		authority := k.GetNameAuthority(ctx, name)
+		if authority.Status == types.AuthorityInRedemption {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name in redemption period.")
+		}
+
 		if authority.Status != types.AuthorityExpired {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name already reserved.")
 		}
@@ -491,8 +631,8 @@ func (k Keeper) createAuthority(ctx sdk.Context, name string, owner sdk.AccAddre
 		ExpiryTime: ctx.BlockTime().Add(moduleParams.AuthorityGracePeriod),
 	}
 
-	// Create auction if root authority and name auctions are enabled.
-	if isRoot && moduleParams.AuthorityAuctionEnabled {
+	// Create auction if requested and name auctions are enabled.
+	if useAuction && moduleParams.AuthorityAuctionEnabled {
 		// If auctions are enabled, clear out owner fields. They will be set after a winner is picked.
 		authority.OwnerAddress = ""
 		authority.OwnerPublicKey = ""
@@ -513,24 +653,30 @@ func (k Keeper) createAuthority(ctx sdk.Context, name string, owner sdk.AccAddre
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid name auction reveal fee.")
 		}
 
-		minimumBid, err := sdk.ParseCoin(moduleParams.MinimumBid)
+		minimumBid, err := sdk.ParseCoin(moduleParams.GetAuthorityMinimumBid(name))
 		if err != nil {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid name auction minimum bid.")
 		}
 
+		proceeds, err := auction.ParseProceedsPolicy(moduleParams.AuctionProceeds)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid name auction proceeds policy.")
+		}
+
 		params := auction.Params{
 			CommitsDuration: moduleParams.CommitsDuration,
 			RevealsDuration: moduleParams.RevealsDuration,
 			CommitFee:       commitFee,
 			RevealFee:       revealFee,
 			MinimumBid:      minimumBid,
+			Proceeds:        proceeds,
 		}
 
-		// Create an auction.
+		// Create an auction, owned by the nameservice (not the first claimant).
 		msg := auction.NewMsgCreateAuction(params, owner)
 
 		// TODO(ashwin): Perhaps consume extra gas for auction creation.
-		auction, sdkErr := k.auctionKeeper.CreateAuction(ctx, msg)
+		auction, sdkErr := k.auctionKeeper.CreateModuleAuction(ctx, types.AuthorityRentModuleAccountName, msg)
 		if sdkErr != nil {
 			return sdkErr
 		}
@@ -543,6 +689,9 @@ func (k Keeper) createAuthority(ctx sdk.Context, name string, owner sdk.AccAddre
 		authority.ExpiryTime = auction.RevealsEndTime.Add(moduleParams.AuthorityGracePeriod)
 	}
 
+	// Grants from a previous registration no longer apply.
+	deleteGrants(ctx.KVStore(k.storeKey), name)
+
 	k.SetNameAuthority(ctx, name, authority)
 	k.InsertAuthorityExpiryQueue(ctx, name, authority.ExpiryTime)
 
@@ -561,18 +710,35 @@ func (k Keeper) ProcessReserveSubAuthority(ctx sdk.Context, name string, msg typ
 		return name, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Parent authority not found.")
 	}
 
-	// Sub-authority creator needs to be the owner of the parent authority.
-	if parentAuthority.OwnerAddress != msg.Signer.String() {
+	// Sub-authority creator needs to be the owner of the parent authority,
+	// or allowed to reserve sub-authorities by the parent authority policy.
+	isParentOwner := parentAuthority.OwnerAddress == msg.Signer.String()
+	if !isParentOwner && !parentAuthority.SubAuthorityPolicy.Allows(msg.Signer.String()) {
 		return name, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
 	}
 
-	// Sub-authority owner defaults to parent authority owner.
+	if !isParentOwner && parentAuthority.Status != types.AuthorityActive {
+		return name, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Parent authority is not active.")
+	}
+
+	if err := k.checkSubAuthorityDepth(ctx, names); err != nil {
+		return name, err
+	}
+
+	// Sub-authority owner defaults to the signer (i.e. the parent authority owner or registrant).
 	subAuthorityOwner := msg.Signer
 	if !msg.Owner.Empty() {
 		// Override sub-authority owner if provided in message.
 		subAuthorityOwner = msg.Owner
 	}
 
+	if !isParentOwner {
+		if err := k.paySubAuthorityFee(ctx, *parentAuthority, msg.Signer); err != nil {
+			return name, err
+		}
+	}
+
+	// Sub-authorities are never auctioned.
 	sdkErr := k.createAuthority(ctx, name, subAuthorityOwner, false)
 	if sdkErr != nil {
 		return "", sdkErr
@@ -581,6 +747,81 @@ func (k Keeper) ProcessReserveSubAuthority(ctx sdk.Context, name string, msg typ
 	return name, nil
 }
 
+// checkSubAuthorityDepth checks the max. depth set by the sub-authority policies of the ancestor authorities.
+func (k Keeper) checkSubAuthorityDepth(ctx sdk.Context, names []string) error {
+	for depth := 1; depth < len(names); depth++ {
+		ancestor := k.GetNameAuthority(ctx, strings.Join(names[depth:], "."))
+		if ancestor == nil {
+			continue
+		}
+
+		maxDepth := ancestor.SubAuthorityPolicy.MaxDepth
+		if maxDepth > 0 && int64(depth) > maxDepth {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Max. sub-authority depth exceeded.")
+		}
+	}
+
+	return nil
+}
+
+// paySubAuthorityFee moves the sub-authority fee from the registrant to the parent authority bond, or owner if it has no bond.
+func (k Keeper) paySubAuthorityFee(ctx sdk.Context, parentAuthority types.NameAuthority, registrant sdk.AccAddress) error {
+	fee := parentAuthority.SubAuthorityPolicy.Fee
+	if fee.Empty() {
+		return nil
+	}
+
+	// Fee passes through the authority rent module account.
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, registrant, types.AuthorityRentModuleAccountName, fee)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "Insufficient funds for sub-authority fee.")
+	}
+
+	if parentAuthority.BondID != "" && k.bondKeeper.HasBond(ctx, parentAuthority.BondID) {
+		return k.bondKeeper.TransferCoinsFromModuleAccount(ctx, parentAuthority.BondID, types.AuthorityRentModuleAccountName, fee)
+	}
+
+	ownerAddress, err := sdk.AccAddressFromBech32(parentAuthority.OwnerAddress)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid parent authority owner address.")
+	}
+
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.AuthorityRentModuleAccountName, ownerAddress, fee)
+}
+
+// ProcessSetSubAuthorityPolicy sets the policy for third parties reserving sub-authorities.
+func (k Keeper) ProcessSetSubAuthorityPolicy(ctx sdk.Context, msg types.MsgSetSubAuthorityPolicy) error {
+	name, err := types.NormalizeName(msg.Name)
+	if err != nil {
+		return err
+	}
+
+	msg.Name = name
+
+	authority := k.GetNameAuthority(ctx, msg.Name)
+	if authority == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name authority not found.")
+	}
+
+	if !authority.OwnerPolicy.IsSet() && authority.OwnerAddress != msg.Signer.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	if authority.Status != types.AuthorityActive {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Authority is not active.")
+	}
+
+	approved, err := k.approveAuthorityAction(ctx, msg.Name, *authority, msg.Signer, types.NewMsgSetSubAuthorityPolicy(msg.Name, msg.Policy(), nil))
+	if err != nil || !approved {
+		return err
+	}
+
+	authority.SubAuthorityPolicy = msg.Policy()
+	k.SetNameAuthority(ctx, msg.Name, *authority)
+
+	return nil
+}
+
 func getAuthorityPubKey(pubKey crypto.PubKey) string {
 	if pubKey != nil {
 		return helpers.BytesToBase64(pubKey.Bytes())
@@ -604,30 +845,39 @@ func (k Keeper) getAuthority(ctx sdk.Context, wrn string) (string, *url.URL, *ty
 	return name, parsedWRN, authority, nil
 }
 
-func (k Keeper) checkWRNAccess(ctx sdk.Context, signer sdk.AccAddress, wrn string) error {
+// checkWRNAccess checks if the signer can update names under the WRN authority.
+// Returns false if the update is pending approval by more owners (authorities with an owner policy).
+func (k Keeper) checkWRNAccess(ctx sdk.Context, signer sdk.AccAddress, wrn string, action sdk.Msg) (bool, error) {
 	name, parsedWRN, authority, err := k.getAuthority(ctx, wrn)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	formattedWRN := fmt.Sprintf("wrn://%s%s", name, parsedWRN.RequestURI())
 	if formattedWRN != wrn {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid WRN.")
+		return false, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid WRN.")
 	}
 
-	if authority.OwnerAddress != signer.String() {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	// Grantees can update matching names without owner approval.
+	granted := authority.OwnerAddress != signer.String() && k.hasNameGrant(ctx, name, signer, wrn, action.Type())
+
+	if !granted && !authority.OwnerPolicy.IsSet() && authority.OwnerAddress != signer.String() {
+		return false, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
 	}
 
 	if authority.Status != types.AuthorityActive {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Authority is not active.")
+		return false, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Authority is not active.")
 	}
 
 	if authority.BondID == "" {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Authority bond not found.")
+		return false, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Authority bond not found.")
 	}
 
-	if authority.OwnerPublicKey == "" {
+	if granted {
+		return true, nil
+	}
+
+	if authority.OwnerPublicKey == "" && authority.OwnerAddress == signer.String() {
 		// Try to set owner public key if account has it available now.
 		ownerAccount := k.accountKeeper.GetAccount(ctx, signer)
 		pubKey := ownerAccount.GetPubKey()
@@ -638,16 +888,175 @@ func (k Keeper) checkWRNAccess(ctx sdk.Context, signer sdk.AccAddress, wrn strin
 		}
 	}
 
+	return k.approveAuthorityAction(ctx, name, *authority, signer, action)
+}
+
+// approveAuthorityAction records an authority owner's approval for an action.
+// Authorities without an owner policy are controlled by the owner alone.
+func (k Keeper) approveAuthorityAction(ctx sdk.Context, name string, authority types.NameAuthority, signer sdk.AccAddress, action sdk.Msg) (bool, error) {
+	if !authority.OwnerPolicy.IsSet() {
+		return authority.OwnerAddress == signer.String(), nil
+	}
+
+	return k.approve(ctx, name, action, authority.OwnerPolicy.Owners, authority.OwnerPolicy.Threshold, signer.String())
+}
+
+// ProcessSetAuthorityPolicy sets (or clears) the M-of-N owner policy for an authority.
+func (k Keeper) ProcessSetAuthorityPolicy(ctx sdk.Context, msg types.MsgSetAuthorityPolicy) error {
+	name, err := types.NormalizeName(msg.Name)
+	if err != nil {
+		return err
+	}
+
+	msg.Name = name
+
+	authority := k.GetNameAuthority(ctx, msg.Name)
+	if authority == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name authority not found.")
+	}
+
+	if !authority.OwnerPolicy.IsSet() && authority.OwnerAddress != msg.Signer.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	if authority.Status != types.AuthorityActive {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Authority is not active.")
+	}
+
+	// Changing the policy requires approval under the current policy.
+	approved, err := k.approveAuthorityAction(ctx, msg.Name, *authority, msg.Signer, types.NewMsgSetAuthorityPolicy(msg.Name, msg.Owners, msg.Threshold, nil))
+	if err != nil || !approved {
+		return err
+	}
+
+	authority.OwnerPolicy = types.OwnerPolicy{Owners: msg.Owners, Threshold: msg.Threshold}
+	k.SetNameAuthority(ctx, msg.Name, *authority)
+
+	// Approvals collected under the old policy no longer apply.
+	k.deleteAuthorityApprovals(ctx, msg.Name)
+
 	return nil
 }
 
+// ProcessTransferAuthority transfers ownership of an authority, or starts a two-step transfer.
+func (k Keeper) ProcessTransferAuthority(ctx sdk.Context, msg types.MsgTransferAuthority) error {
+	name, err := types.NormalizeName(msg.Name)
+	if err != nil {
+		return err
+	}
+
+	msg.Name = name
+
+	authority := k.GetNameAuthority(ctx, msg.Name)
+	if authority == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name authority not found.")
+	}
+
+	if !authority.OwnerPolicy.IsSet() && authority.OwnerAddress != msg.Signer.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	if authority.Status != types.AuthorityActive {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Authority is not active.")
+	}
+
+	approved, err := k.approveAuthorityAction(ctx, msg.Name, *authority, msg.Signer, types.NewMsgTransferAuthority(msg.Name, msg.NewOwner, msg.TwoStep, nil))
+	if err != nil || !approved {
+		return err
+	}
+
+	if !msg.TwoStep {
+		k.transferAuthority(ctx, msg.Name, *authority, msg.NewOwner)
+		return nil
+	}
+
+	// Two-step transfer to the current owner cancels a pending transfer.
+	authority.PendingOwnerAddress = msg.NewOwner.String()
+	if authority.PendingOwnerAddress == authority.OwnerAddress {
+		authority.PendingOwnerAddress = ""
+	}
+
+	k.SetNameAuthority(ctx, msg.Name, *authority)
+
+	return nil
+}
+
+// ProcessAcceptAuthority completes a two-step authority transfer.
+func (k Keeper) ProcessAcceptAuthority(ctx sdk.Context, msg types.MsgAcceptAuthority) error {
+	name, err := types.NormalizeName(msg.Name)
+	if err != nil {
+		return err
+	}
+
+	msg.Name = name
+
+	authority := k.GetNameAuthority(ctx, msg.Name)
+	if authority == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name authority not found.")
+	}
+
+	if authority.PendingOwnerAddress == "" || authority.PendingOwnerAddress != msg.Signer.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "No pending transfer to signer.")
+	}
+
+	if authority.Status != types.AuthorityActive {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Authority is not active.")
+	}
+
+	k.transferAuthority(ctx, msg.Name, *authority, msg.Signer)
+
+	return nil
+}
+
+// transferAuthority makes newOwner the (sole) owner of the authority.
+func (k Keeper) transferAuthority(ctx sdk.Context, name string, authority types.NameAuthority, newOwner sdk.AccAddress) {
+	authority.OwnerAddress = newOwner.String()
+	authority.PendingOwnerAddress = ""
+
+	// PubKey is only set on first tx from the account, so it might be empty.
+	// In that case, it's set later during a "set WRN -> CID" Tx.
+	authority.OwnerPublicKey = ""
+	ownerAccount := k.accountKeeper.GetAccount(ctx, newOwner)
+	if ownerAccount != nil {
+		authority.OwnerPublicKey = getAuthorityPubKey(ownerAccount.GetPubKey())
+	}
+
+	// Owner and sub-authority policies, and grants, were set up by the previous owner(s).
+	authority.OwnerPolicy = types.OwnerPolicy{}
+	authority.SubAuthorityPolicy = types.SubAuthorityPolicy{}
+	deleteGrants(ctx.KVStore(k.storeKey), name)
+	k.deleteAuthorityApprovals(ctx, name)
+
+	// Reset bond ID if required, as owner has changed.
+	if authority.BondID != "" {
+		k.RemoveBondToAuthorityIndexEntry(ctx, authority.BondID, name)
+		authority.BondID = ""
+	}
+
+	k.SetNameAuthority(ctx, name, authority)
+
+	ctx.Logger().Info(fmt.Sprintf("Authority ownership transferred: %s", name))
+}
+
 // ProcessSetName creates a WRN -> Record ID mapping.
 func (k Keeper) ProcessSetName(ctx sdk.Context, msg types.MsgSetName) error {
-	err := k.checkWRNAccess(ctx, msg.Signer, msg.WRN)
+	wrn, err := types.NormalizeWRN(msg.WRN)
 	if err != nil {
 		return err
 	}
 
+	msg.WRN = wrn
+
+	// Names with a version suffix can't be resolved, as the suffix is parsed as a version (range).
+	if _, _, found := types.ParseVersionedWRN(msg.WRN); found {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name can't have a version suffix.")
+	}
+
+	approved, err := k.checkWRNAccess(ctx, msg.Signer, msg.WRN, types.NewMsgSetName(msg.WRN, string(msg.ID), nil))
+	if err != nil || !approved {
+		return err
+	}
+
 	nameRecord := k.GetNameRecord(ctx, msg.WRN)
 	if nameRecord != nil && nameRecord.ID == msg.ID {
 		// Already pointing to same ID, no-op.
@@ -659,13 +1068,75 @@ func (k Keeper) ProcessSetName(ctx sdk.Context, msg types.MsgSetName) error {
 	return nil
 }
 
+// ProcessSetAlias creates a WRN -> WRN mapping.
+func (k Keeper) ProcessSetAlias(ctx sdk.Context, msg types.MsgSetAlias) error {
+	wrn, err := types.NormalizeWRN(msg.WRN)
+	if err != nil {
+		return err
+	}
+
+	target, err := types.NormalizeWRN(msg.Target)
+	if err != nil {
+		return err
+	}
+
+	msg.WRN = wrn
+	msg.Target = target
+
+	if _, _, found := types.ParseVersionedWRN(msg.WRN); found {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Alias can't have a version suffix.")
+	}
+
+	if _, _, found := types.ParseVersionedWRN(msg.Target); found {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Alias target can't have a version suffix.")
+	}
+
+	// Check the alias doesn't create a loop or a chain longer than the max. depth.
+	chain, err := GetAliasChain(ctx.KVStore(k.storeKey), k.cdc, msg.Target)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range chain {
+		if name == msg.WRN {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Alias loop.")
+		}
+	}
+
+	if len(chain) > types.MaxAliasDepth {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Alias chain is too long.")
+	}
+
+	approved, err := k.checkWRNAccess(ctx, msg.Signer, msg.WRN, types.NewMsgSetAlias(msg.WRN, msg.Target, nil))
+	if err != nil || !approved {
+		return err
+	}
+
+	nameRecord := k.GetNameRecord(ctx, msg.WRN)
+	if nameRecord != nil && nameRecord.Alias == msg.Target {
+		// Already pointing to same WRN, no-op.
+		return nil
+	}
+
+	k.SetNameAlias(ctx, msg.WRN, msg.Target)
+
+	return nil
+}
+
 // ProcessDeleteName removes a WRN -> Record ID mapping.
 func (k Keeper) ProcessDeleteName(ctx sdk.Context, msg types.MsgDeleteName) error {
-	err := k.checkWRNAccess(ctx, msg.Signer, msg.WRN)
+	wrn, err := types.NormalizeWRN(msg.WRN)
 	if err != nil {
 		return err
 	}
 
+	msg.WRN = wrn
+
+	approved, err := k.checkWRNAccess(ctx, msg.Signer, msg.WRN, types.NewMsgDeleteName(msg.WRN, nil))
+	if err != nil || !approved {
+		return err
+	}
+
 	if !k.HasNameRecord(ctx, msg.WRN) {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name not found.")
 	}
@@ -676,6 +1147,100 @@ func (k Keeper) ProcessDeleteName(ctx sdk.Context, msg types.MsgDeleteName) erro
 	return nil
 }
 
+// GetAuthorityRenewal returns the projected expiry time and cost of renewing an authority for a number of rent periods.
+func (k Keeper) GetAuthorityRenewal(ctx sdk.Context, name string, periods int64) (*types.AuthorityRenewal, error) {
+	authority := k.GetNameAuthority(ctx, name)
+	if authority == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name authority not found.")
+	}
+
+	if authority.Status != types.AuthorityActive {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Authority is not active.")
+	}
+
+	if periods < 1 || periods > types.MaxAuthorityRenewalPeriods {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Periods should be between 1 and %d.", types.MaxAuthorityRenewalPeriods))
+	}
+
+	params := k.GetParams(ctx)
+
+	rent, err := sdk.ParseCoins(params.GetAuthorityRent(name))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid authority rent.")
+	}
+
+	cost := sdk.NewCoins()
+	for i := int64(0); i < periods; i++ {
+		cost = cost.Add(rent...)
+	}
+
+	// Extend from the current expiry time.
+	expiryTime := authority.ExpiryTime
+	if expiryTime.Before(ctx.BlockTime()) {
+		expiryTime = ctx.BlockTime()
+	}
+
+	projectedExpiryTime := expiryTime.Add(time.Duration(periods) * params.AuthorityRentDuration)
+
+	// Limit rent paid in advance.
+	if projectedExpiryTime.After(ctx.BlockTime().Add(types.MaxAuthorityRenewalPeriods * params.AuthorityRentDuration)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Rent can't be paid for more than %d periods in advance.", types.MaxAuthorityRenewalPeriods))
+	}
+
+	return &types.AuthorityRenewal{
+		Name:                name,
+		ExpiryTime:          authority.ExpiryTime,
+		Periods:             periods,
+		ProjectedExpiryTime: projectedExpiryTime,
+		Cost:                cost,
+	}, nil
+}
+
+// ProcessRenewAuthority prepays authority rent (from the authority bond) for one or more periods.
+func (k Keeper) ProcessRenewAuthority(ctx sdk.Context, msg types.MsgRenewAuthority) error {
+	name, err := types.NormalizeName(msg.Name)
+	if err != nil {
+		return err
+	}
+
+	msg.Name = name
+
+	authority := k.GetNameAuthority(ctx, msg.Name)
+	if authority == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name authority not found.")
+	}
+
+	if !authority.OwnerPolicy.IsSet() && authority.OwnerAddress != msg.Signer.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	if authority.BondID == "" || !k.bondKeeper.HasBond(ctx, authority.BondID) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Authority bond not found.")
+	}
+
+	renewal, err := k.GetAuthorityRenewal(ctx, msg.Name, msg.GetPeriods())
+	if err != nil {
+		return err
+	}
+
+	approved, err := k.approveAuthorityAction(ctx, msg.Name, *authority, msg.Signer, types.NewMsgRenewAuthority(msg.Name, msg.Periods, nil))
+	if err != nil || !approved {
+		return err
+	}
+
+	err = k.bondKeeper.TransferCoinsToModuleAccount(ctx, authority.BondID, types.AuthorityRentModuleAccountName, renewal.Cost)
+	if err != nil {
+		return err
+	}
+
+	k.DeleteAuthorityExpiryQueue(ctx, msg.Name, *authority)
+	authority.ExpiryTime = renewal.ProjectedExpiryTime
+	k.InsertAuthorityExpiryQueue(ctx, msg.Name, authority.ExpiryTime)
+	k.SetNameAuthority(ctx, msg.Name, *authority)
+
+	return nil
+}
+
 func getAuthorityExpiryQueueTimeKey(timestamp time.Time) []byte {
 	timeBytes := sdk.FormatTimeBytes(timestamp)
 	return append(PrefixExpiryTimeToAuthoritiesIndex, timeBytes...)
@@ -706,20 +1271,29 @@ func (k Keeper) SetAuthorityExpiryQueueTimeSlice(ctx sdk.Context, timestamp time
 }
 
 // ProcessAuthorityExpiryQueue tries to renew expiring authorities (by collecting rent) else marks them as expired.
+// Authorities at the end of the redemption period are released.
 func (k Keeper) ProcessAuthorityExpiryQueue(ctx sdk.Context) {
 	names := k.GetAllExpiredAuthorities(ctx, ctx.BlockHeader().Time)
 	for _, name := range names {
 		authority := k.GetNameAuthority(ctx, name)
 
-		// If authority doesn't have an associated bond or if bond no longer exists, mark it expired.
-		if authority.BondID == "" || !k.bondKeeper.HasBond(ctx, authority.BondID) {
+		if authority.Status == types.AuthorityInRedemption {
 			authority.Status = types.AuthorityExpired
 			k.SetNameAuthority(ctx, name, *authority)
 			k.DeleteAuthorityExpiryQueue(ctx, name, *authority)
 
+			ctx.Logger().Info(fmt.Sprintf("Redemption period over, releasing authority: %s", name))
+
+			continue
+		}
+
+		// If authority doesn't have an associated bond or if bond no longer exists, mark it expired.
+		if authority.BondID == "" || !k.bondKeeper.HasBond(ctx, authority.BondID) {
+			k.expireAuthority(ctx, name, *authority)
+
 			ctx.Logger().Info(fmt.Sprintf("Marking authority expired as no bond present: %s", name))
 
-			return
+			continue
 		}
 
 		// Try to renew the authority by taking rent.
@@ -794,7 +1368,7 @@ func (k Keeper) TryTakeAuthorityRent(ctx sdk.Context, name string, authority typ
 
 	params := k.GetParams(ctx)
 
-	rent, err := sdk.ParseCoins(params.AuthorityRent)
+	rent, err := sdk.ParseCoins(params.GetAuthorityRent(name))
 	if err != nil {
 		panic("Invalid authority rent.")
 	}
@@ -802,9 +1376,7 @@ func (k Keeper) TryTakeAuthorityRent(ctx sdk.Context, name string, authority typ
 	sdkErr := k.bondKeeper.TransferCoinsToModuleAccount(ctx, authority.BondID, types.AuthorityRentModuleAccountName, rent)
 	if sdkErr != nil {
 		// Insufficient funds, mark authority as expired.
-		authority.Status = types.AuthorityExpired
-		k.SetNameAuthority(ctx, name, authority)
-		k.DeleteAuthorityExpiryQueue(ctx, name, authority)
+		k.expireAuthority(ctx, name, authority)
 
 		ctx.Logger().Info(fmt.Sprintf("Insufficient funds in owner account to pay authority rent, marking as expired: %s", name))
 
@@ -823,3 +1395,81 @@ func (k Keeper) TryTakeAuthorityRent(ctx sdk.Context, name string, authority typ
 
 	ctx.Logger().Info(fmt.Sprintf("Authority rent paid successfully: %s", name))
 }
+
+// expireAuthority marks an authority as expired, or starts the redemption period if the authority has an owner.
+func (k Keeper) expireAuthority(ctx sdk.Context, name string, authority types.NameAuthority) {
+	k.DeleteAuthorityExpiryQueue(ctx, name, authority)
+
+	params := k.GetParams(ctx)
+	if params.AuthorityRedemptionPeriod == 0 || authority.Status != types.AuthorityActive || authority.OwnerAddress == "" {
+		authority.Status = types.AuthorityExpired
+		k.SetNameAuthority(ctx, name, authority)
+
+		return
+	}
+
+	// Expiry queue releases the authority at the end of the redemption period.
+	authority.Status = types.AuthorityInRedemption
+	authority.ExpiryTime = ctx.BlockTime().Add(params.AuthorityRedemptionPeriod)
+	k.InsertAuthorityExpiryQueue(ctx, name, authority.ExpiryTime)
+	k.SetNameAuthority(ctx, name, authority)
+}
+
+// ProcessRedeemAuthority restores an authority during its redemption period, charging rent plus a penalty.
+func (k Keeper) ProcessRedeemAuthority(ctx sdk.Context, msg types.MsgRedeemAuthority) error {
+	name, err := types.NormalizeName(msg.Name)
+	if err != nil {
+		return err
+	}
+
+	msg.Name = name
+
+	authority := k.GetNameAuthority(ctx, msg.Name)
+	if authority == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name authority not found.")
+	}
+
+	if authority.Status != types.AuthorityInRedemption {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Authority is not in redemption period.")
+	}
+
+	if !authority.OwnerPolicy.IsSet() && authority.OwnerAddress != msg.Signer.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	if authority.BondID == "" || !k.bondKeeper.HasBond(ctx, authority.BondID) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Authority bond not found.")
+	}
+
+	approved, err := k.approveAuthorityAction(ctx, msg.Name, *authority, msg.Signer, types.NewMsgRedeemAuthority(msg.Name, nil))
+	if err != nil || !approved {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+
+	rent, err := sdk.ParseCoins(params.GetAuthorityRent(msg.Name))
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid authority rent.")
+	}
+
+	penalty, err := sdk.ParseCoins(params.AuthorityRedemptionPenalty)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid authority redemption penalty.")
+	}
+
+	err = k.bondKeeper.TransferCoinsToModuleAccount(ctx, authority.BondID, types.AuthorityRentModuleAccountName, rent.Add(penalty...))
+	if err != nil {
+		return err
+	}
+
+	k.DeleteAuthorityExpiryQueue(ctx, msg.Name, *authority)
+	authority.ExpiryTime = ctx.BlockTime().Add(params.AuthorityRentDuration)
+	k.InsertAuthorityExpiryQueue(ctx, msg.Name, authority.ExpiryTime)
+
+	authority.Status = types.AuthorityActive
+	k.SetNameAuthority(ctx, msg.Name, *authority)
+	k.AddBondToAuthorityIndexEntry(ctx, authority.BondID, msg.Name)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+func TestGetAuthorityRentByUnicodeLength(t *testing.T) {
+	input := createTestInput(t)
+
+	params := input.keeper.GetParams(input.ctx)
+	params.AuthorityLengthPrices = []types.AuthorityLengthPrice{{MaxLength: 3, Rent: "100000000uwire"}}
+	require.NoError(t, params.Validate())
+
+	// Punycode names are priced by their length in (unicode) characters.
+	name, err := types.NormalizeName("äbc")
+	require.NoError(t, err)
+	require.Equal(t, "100000000uwire", params.GetAuthorityRent(name))
+	require.Equal(t, params.AuthorityRent, params.GetAuthorityRent("abcd"))
+
+	// Premium names must be in canonical form.
+	params.AuthorityPremiumPrices = []types.AuthorityPremiumPrice{{Name: "ÄBC", Rent: "100000000uwire"}}
+	require.Error(t, params.Validate())
+
+	params.AuthorityPremiumPrices = []types.AuthorityPremiumPrice{{Name: name, Rent: "200000000uwire"}}
+	require.NoError(t, params.Validate())
+	require.Equal(t, "200000000uwire", params.GetAuthorityRent(name))
+}
+
+func TestProcessSetNameRejectsVersionSuffix(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+	_, owner := input.createTestAccount(t, "1000000uwire")
+
+	// Resolving the name would look up wrn://example/app instead.
+	msg := types.NewMsgSetName("wrn://example/app@1.0.0", "record-1", owner)
+	require.Error(t, msg.ValidateBasic())
+	require.Error(t, k.ProcessSetName(ctx, msg))
+	require.False(t, k.HasNameRecord(ctx, "wrn://example/app@1.0.0"))
+
+	// Names without a version suffix are accepted.
+	require.NoError(t, types.NewMsgSetName("wrn://example/app", "record-1", owner).ValidateBasic())
+}
+
+func TestProcessReserveAuthorityAuctionsUnreservedNames(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+	_, owner := input.createTestAccount(t, "1000000000uwire")
+
+	params := types.DefaultParams()
+	params.AuthorityAuctionEnabled = true
+	k.SetParams(ctx, params)
+	k.SetReservedName(ctx, types.ReservedName{Name: "reserved", Address: owner.String()})
+
+	_, err := k.ProcessReserveAuthority(ctx, types.NewMsgReserveAuthority("open", owner, nil))
+	require.NoError(t, err)
+	require.Equal(t, types.AuthorityUnderAuction, k.GetNameAuthority(ctx, "open").Status)
+
+	// Reserved names are assigned directly, i.e. without an auction.
+	_, err = k.ProcessReserveAuthority(ctx, types.NewMsgReserveAuthority("reserved", owner, nil))
+	require.NoError(t, err)
+	reserved := k.GetNameAuthority(ctx, "reserved")
+	require.Equal(t, types.AuthorityActive, reserved.Status)
+	require.Equal(t, owner.String(), reserved.OwnerAddress)
+	require.Empty(t, reserved.AuctionID)
+}
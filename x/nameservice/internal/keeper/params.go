@@ -5,6 +5,8 @@
 package keeper
 
 import (
+	"reflect"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/vulcanize/dxns/x/nameservice/internal/types"
 )
@@ -19,3 +21,13 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
 }
+
+// MigrateParams sets the params missing from the store (e.g. on chains started before they were added) to the defaults.
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !k.paramSubspace.Has(ctx, pair.Key) {
+			k.paramSubspace.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+		}
+	}
+}
@@ -0,0 +1,34 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	input := createTestInput(t)
+	ctx := input.ctx
+
+	// Chain started before most of the params were added.
+	k := input.keeper
+	keeper := NewKeeper(k.accountKeeper, k.supplyKeeper, k.recordKeeper, k.bondKeeper, k.auctionKeeper, k.storeKey, k.cdc, input.paramsKeeper.Subspace("legacy"))
+	keeper.paramSubspace.Set(ctx, types.KeyRecordRent, "2000000uwire")
+	require.Panics(t, func() { keeper.GetParams(ctx) })
+
+	keeper.MigrateParams(ctx)
+
+	// Params already set are kept, the rest are set to the defaults.
+	expected := types.DefaultParams()
+	expected.RecordRent = "2000000uwire"
+	params := keeper.GetParams(ctx)
+	require.Empty(t, params.AuthorityLengthPrices)
+	require.Empty(t, params.AuthorityPremiumPrices)
+	expected.AuthorityLengthPrices, expected.AuthorityPremiumPrices = params.AuthorityLengthPrices, params.AuthorityPremiumPrices
+	require.Equal(t, expected, params)
+}
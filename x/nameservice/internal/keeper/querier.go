@@ -6,8 +6,10 @@ package keeper
 
 import (
 	"encoding/json"
+	"strconv"
 	"strings"
 
+	wnsUtils "github.com/vulcanize/dxns/utils"
 	"github.com/vulcanize/dxns/x/bond"
 	"github.com/vulcanize/dxns/x/nameservice/internal/types"
 
@@ -22,8 +24,14 @@ const (
 	ListRecordsPath        = "list"
 	GetRecordPath          = "get"
 	QueryRecordsByBondPath = "query-by-bond"
+	ReferencedByPath       = "referenced-by"
 	QueryParametersPath    = "parameters"
 	Balance                = "balance"
+	GetRecordSchemaPath    = "schema"
+	ListRecordSchemasPath  = "schemas"
+	ListApprovalsPath      = "approvals"
+	ListGrantsPath         = "grants"
+	ListNameListsPath      = "name-lists"
 
 	WhoIsPath       = "whois"
 	LookUpWRNPath   = "lookup"
@@ -32,6 +40,7 @@ const (
 
 	RecordExpiryQueue    = "record-expiry"
 	AuthorityExpiryQueue = "authority-expiry"
+	AuthorityRenewalPath = "authority-renewal"
 )
 
 // NewQuerier is the module level router for state queries
@@ -52,6 +61,18 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return resolveName(ctx, path[1:], req, keeper)
 		case QueryRecordsByBondPath:
 			return queryRecordsByBond(ctx, path[1:], req, keeper)
+		case ReferencedByPath:
+			return queryRecordsReferencing(ctx, path[1:], req, keeper)
+		case GetRecordSchemaPath:
+			return getRecordSchema(ctx, path[1:], req, keeper)
+		case ListRecordSchemasPath:
+			return listRecordSchemas(ctx, path[1:], req, keeper)
+		case ListApprovalsPath:
+			return listApprovals(ctx, path[1:], req, keeper)
+		case ListGrantsPath:
+			return listGrants(ctx, path[1:], req, keeper)
+		case ListNameListsPath:
+			return listNameLists(ctx, path[1:], req, keeper)
 		case QueryParametersPath:
 			return queryParameters(ctx, path[1:], req, keeper)
 		case Balance:
@@ -60,6 +81,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryRecordExpiryQueue(ctx, path[1:], req, keeper)
 		case AuthorityExpiryQueue:
 			return queryAuthorityExpiryQueue(ctx, path[1:], req, keeper)
+		case AuthorityRenewalPath:
+			return queryAuthorityRenewal(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown nameservice query endpoint")
 		}
@@ -67,7 +90,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 }
 
 func whoIs(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
-	name := path[0]
+	name, err := types.NormalizeName(path[0])
+	if err != nil {
+		return nil, err
+	}
 
 	if !keeper.HasNameAuthority(ctx, name) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name not found.")
@@ -102,7 +128,12 @@ func lookupWRN(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 
 // nolint: unparam
 func listResources(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
-	records := keeper.ListRecords(ctx)
+	pagination, err := wnsUtils.ParsePagination(req.Data)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid pagination params.")
+	}
+
+	records := keeper.ListRecords(ctx, pagination)
 
 	bz, err2 := json.MarshalIndent(records, "", "  ")
 	if err2 != nil {
@@ -132,7 +163,12 @@ func getResource(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 
 // nolint: unparam
 func listNames(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
-	records := keeper.ListNameRecords(ctx)
+	pagination, err := wnsUtils.ParsePagination(req.Data)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid pagination params.")
+	}
+
+	records := keeper.ListNameRecords(ctx, pagination)
 
 	bz, err2 := json.MarshalIndent(records, "", "  ")
 	if err2 != nil {
@@ -156,6 +192,19 @@ func resolveName(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 	return bz, nil
 }
 
+// nolint: unparam
+func queryRecordsReferencing(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
+	id := types.ID(strings.Join(path, "/"))
+	records := keeper.QueryRecordsReferencing(ctx, id)
+
+	bz, err2 := json.MarshalIndent(records, "", "  ")
+	if err2 != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
 // nolint: unparam
 func queryRecordsByBond(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
 
@@ -170,6 +219,74 @@ func queryRecordsByBond(ctx sdk.Context, path []string, req abci.RequestQuery, k
 	return bz, nil
 }
 
+// nolint: unparam
+func getRecordSchema(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
+	recordType := strings.Join(path, "/")
+
+	schema := keeper.GetRecordSchema(ctx, recordType)
+	if schema == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Record schema not found.")
+	}
+
+	bz, err2 := json.MarshalIndent(schema, "", "  ")
+	if err2 != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// nolint: unparam
+func listRecordSchemas(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
+	schemas := keeper.ListRecordSchemas(ctx)
+
+	bz, err2 := json.MarshalIndent(schemas, "", "  ")
+	if err2 != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// nolint: unparam
+func listApprovals(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
+	approvals := keeper.ListApprovals(ctx)
+
+	bz, err2 := json.MarshalIndent(approvals, "", "  ")
+	if err2 != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// nolint: unparam
+func listGrants(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
+	grants := keeper.ListGrants(ctx, path[0])
+
+	bz, err2 := json.MarshalIndent(grants, "", "  ")
+	if err2 != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// nolint: unparam
+func listNameLists(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
+	nameLists := types.NameLists{
+		Blocked:  keeper.ListBlockedNames(ctx),
+		Reserved: keeper.ListReservedNames(ctx),
+	}
+
+	bz, err2 := json.MarshalIndent(nameLists, "", "  ")
+	if err2 != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
 func queryParameters(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	params := keeper.GetParams(ctx)
 
@@ -210,3 +327,26 @@ func queryAuthorityExpiryQueue(ctx sdk.Context, path []string, req abci.RequestQ
 
 	return res, nil
 }
+
+// nolint: unparam
+func queryAuthorityRenewal(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
+	periods := int64(1)
+	if len(path) > 1 {
+		periods, err = strconv.ParseInt(path[1], 10, 64)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid periods.")
+		}
+	}
+
+	renewal, err := keeper.GetAuthorityRenewal(ctx, path[0], periods)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err2 := json.MarshalIndent(renewal, "", "  ")
+	if err2 != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
@@ -7,6 +7,7 @@ package keeper
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -55,7 +56,18 @@ func (k Keeper) ProcessSetRecord(ctx sdk.Context, msg types.MsgSetRecord) (*type
 	// Sort owners list.
 	sort.Strings(record.Owners)
 
-	sdkErr := k.processRecord(ctx, &record, false)
+	if msg.OwnerThreshold > int64(len(record.Owners)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Owner threshold exceeds number of owners.")
+	}
+
+	record.OwnerThreshold = msg.OwnerThreshold
+
+	// Reject records that don't match the schema registered for their type.
+	if err := k.validateRecordSchema(ctx, record); err != nil {
+		return nil, err
+	}
+
+	sdkErr := k.processRecord(ctx, &record, false, 1)
 	if sdkErr != nil {
 		return nil, sdkErr
 	}
@@ -63,27 +75,169 @@ func (k Keeper) ProcessSetRecord(ctx sdk.Context, msg types.MsgSetRecord) (*type
 	return &record, nil
 }
 
-// ProcessRenewRecord renews a record.
+// ProcessRenewRecord renews a record, for one or more rent periods.
+// Expired records are renewed from now, live records are extended from the current expiry time.
 func (k Keeper) ProcessRenewRecord(ctx sdk.Context, msg types.MsgRenewRecord) (*types.Record, error) {
 	if !k.HasRecord(ctx, msg.ID) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Record not found.")
 	}
 
-	// Check if renewal is required (i.e. expired record marked as deleted).
 	record := k.GetRecord(ctx, msg.ID)
-	if !record.Deleted || record.ExpiryTime.After(ctx.BlockTime()) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Renewal not required.")
+	periods := msg.GetPeriods()
+
+	// Expired record marked as deleted.
+	if record.Deleted {
+		if record.ExpiryTime.After(ctx.BlockTime()) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Renewal not required.")
+		}
+
+		err := k.processRecord(ctx, &record, true, periods)
+		if err != nil {
+			return nil, err
+		}
+
+		return &record, nil
+	}
+
+	// Live record, extend from current expiry time.
+	params := k.GetParams(ctx)
+	expiryTime := record.ExpiryTime.Add(time.Duration(periods) * params.RecordRentDuration)
+
+	// Limit rent paid in advance.
+	if expiryTime.After(ctx.BlockTime().Add(types.MaxRecordRenewalPeriods * params.RecordRentDuration)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Rent can't be paid for more than %d periods in advance.", types.MaxRecordRenewalPeriods))
+	}
+
+	err := k.takeRecordRent(ctx, &record, periods)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeleteRecordExpiryQueue(ctx, record)
+	record.ExpiryTime = expiryTime
+	k.InsertRecordExpiryQueue(ctx, record)
+	k.PutRecord(ctx, record)
+
+	return &record, nil
+}
+
+// ProcessSetRecordAutoRenew turns automatic renewal (from the record bond) on expiry on/off.
+func (k Keeper) ProcessSetRecordAutoRenew(ctx sdk.Context, msg types.MsgSetRecordAutoRenew) (*types.Record, error) {
+	if !k.HasRecord(ctx, msg.ID) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Record not found.")
+	}
+
+	record := k.GetRecord(ctx, msg.ID)
+
+	// Only record owners can change the renewal policy.
+	approved, err := k.approveRecordAction(ctx, record, msg.Signer, types.NewMsgSetRecordAutoRenew(string(msg.ID), msg.AutoRenew, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	if !approved {
+		// Pending approval by more owners.
+		return &record, nil
+	}
+
+	record.DisableAutoRenew = !msg.AutoRenew
+	k.PutRecord(ctx, record)
+
+	return &record, nil
+}
+
+// ProcessDeleteRecord deletes a record, refunding the unused rent to the record bond.
+func (k Keeper) ProcessDeleteRecord(ctx sdk.Context, msg types.MsgDeleteRecord) (*types.Record, error) {
+	if !k.HasRecord(ctx, msg.ID) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Record not found.")
+	}
+
+	record := k.GetRecord(ctx, msg.ID)
+	if record.Deleted {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Record already deleted.")
 	}
 
-	err := k.processRecord(ctx, &record, true)
+	// Only record owners can delete a record.
+	approved, err := k.approveRecordAction(ctx, record, msg.Signer, types.NewMsgDeleteRecord(string(msg.ID), nil))
 	if err != nil {
 		return nil, err
 	}
 
+	if !approved {
+		// Pending approval by more owners.
+		return &record, nil
+	}
+
+	if record.BondID != "" && k.bondKeeper.HasBond(ctx, record.BondID) {
+		refund, err := k.getRecordRentRefund(ctx, record)
+		if err != nil {
+			return nil, err
+		}
+
+		if !refund.IsZero() {
+			err = k.bondKeeper.TransferCoinsFromModuleAccount(ctx, record.BondID, types.RecordRentModuleAccountName, refund)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	k.DeleteRecordExpiryQueue(ctx, record)
+
+	// Drop name mappings.
+	for _, wrn := range record.Names {
+		k.SetNameRecord(ctx, wrn, "")
+	}
+
+	if record.BondID != "" {
+		k.RemoveBondToRecordIndexEntry(ctx, record.BondID, record.ID)
+	}
+
+	record.Names = nil
+	record.BondID = ""
+	record.ExpiryTime = ctx.BlockTime()
+	record.Deleted = true
+	k.PutRecord(ctx, record)
+
 	return &record, nil
 }
 
-func (k Keeper) processRecord(ctx sdk.Context, record *types.Record, isRenewal bool) error {
+// getRecordRentRefund computes the unused part of the record rent, at the rent paid for it.
+// The refund is capped at the total rent paid for the record.
+func (k Keeper) getRecordRentRefund(ctx sdk.Context, record types.Record) (sdk.Coins, error) {
+	rent, rentDuration, rentPaid := record.Rent, record.RentDuration, record.RentPaid
+
+	// Records created before the rent paid was stored, assume a single period at the current rent.
+	if rent.Empty() {
+		params := k.GetParams(ctx)
+
+		currentRent, err := sdk.ParseCoins(params.RecordRent)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid record rent.")
+		}
+
+		rent, rentDuration, rentPaid = currentRent, params.RecordRentDuration, currentRent
+	}
+
+	// Note: Rent may have been paid for multiple periods in advance.
+	remaining := record.ExpiryTime.Sub(ctx.BlockTime())
+	if remaining <= 0 || rentDuration <= 0 {
+		return sdk.NewCoins(), nil
+	}
+
+	refund := sdk.NewCoins()
+	for _, coin := range rent {
+		amount := coin.Amount.Mul(sdk.NewInt(int64(remaining))).Quo(sdk.NewInt(int64(rentDuration)))
+		amount = sdk.MinInt(amount, rentPaid.AmountOf(coin.Denom))
+		refund = refund.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return refund, nil
+}
+
+// takeRecordRent takes rent for the given number of periods from the record bond,
+// and keeps track of the rent paid on the record.
+func (k Keeper) takeRecordRent(ctx sdk.Context, record *types.Record, periods int64) error {
 	params := k.GetParams(ctx)
 
 	rent, err := sdk.ParseCoins(params.RecordRent)
@@ -91,21 +245,46 @@ func (k Keeper) processRecord(ctx sdk.Context, record *types.Record, isRenewal b
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid record rent.")
 	}
 
-	sdkErr := k.bondKeeper.TransferCoinsToModuleAccount(ctx, record.BondID, types.RecordRentModuleAccountName, rent)
+	totalRent := sdk.NewCoins()
+	for _, coin := range rent {
+		totalRent = totalRent.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(periods)))
+	}
+
+	err = k.bondKeeper.TransferCoinsToModuleAccount(ctx, record.BondID, types.RecordRentModuleAccountName, totalRent)
+	if err != nil {
+		return err
+	}
+
+	record.Rent = rent
+	record.RentDuration = params.RecordRentDuration
+	record.RentPaid = record.RentPaid.Add(totalRent...)
+
+	return nil
+}
+
+func (k Keeper) processRecord(ctx sdk.Context, record *types.Record, isRenewal bool, periods int64) error {
+	params := k.GetParams(ctx)
+
+	// New (or expired) record, previous rent payments don't carry over.
+	record.RentPaid = nil
+
+	sdkErr := k.takeRecordRent(ctx, record, periods)
 	if sdkErr != nil {
 		return sdkErr
 	}
 
 	record.CreateTime = ctx.BlockHeader().Time
-	record.ExpiryTime = ctx.BlockHeader().Time.Add(params.RecordRentDuration)
+	record.ExpiryTime = ctx.BlockHeader().Time.Add(time.Duration(periods) * params.RecordRentDuration)
 	record.Deleted = false
 
 	k.PutRecord(ctx, *record)
 	k.InsertRecordExpiryQueue(ctx, *record)
 
-	// Renewal doesn't change the name and bond indexes.
+	// Renewal doesn't change the name, bond, attribute and reference indexes.
 	if !isRenewal {
 		k.AddBondToRecordIndexEntry(ctx, record.BondID, record.ID)
+		k.AddRecordToAttributeIndexes(ctx, *record)
+		k.AddRecordToReferenceIndex(ctx, *record)
 	}
 
 	return nil
@@ -0,0 +1,76 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+func TestProcessDeleteRecordByOwner(t *testing.T) {
+	input := createTestInput(t)
+	ownerKey, ownerAddress := input.createTestAccount(t, "1000000000uwire")
+	_, otherAddress := input.createTestAccount(t, "1000000000uwire")
+
+	record := input.createTestRecord(t, ownerKey, ownerAddress, map[string]interface{}{
+		"type":    "wrn:protocol",
+		"name":    "test-protocol",
+		"version": "1.0.0",
+	})
+
+	// Other accounts can't delete the record.
+	_, err := input.keeper.ProcessDeleteRecord(input.ctx, types.NewMsgDeleteRecord(string(record.ID), otherAddress))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+	require.False(t, input.keeper.GetRecord(input.ctx, record.ID).Deleted)
+
+	// The owner can.
+	_, err = input.keeper.ProcessDeleteRecord(input.ctx, types.NewMsgDeleteRecord(string(record.ID), ownerAddress))
+	require.NoError(t, err)
+	require.True(t, input.keeper.GetRecord(input.ctx, record.ID).Deleted)
+}
+
+func TestProcessRecordExpiryQueueAutoRenew(t *testing.T) {
+	input := createTestInput(t)
+	ownerKey, ownerAddress := input.createTestAccount(t, "1000000000uwire")
+	params := input.keeper.GetParams(input.ctx)
+
+	renewed := input.createTestRecord(t, ownerKey, ownerAddress, map[string]interface{}{"type": "test", "name": "renewed"})
+	expired := input.createTestRecord(t, ownerKey, ownerAddress, map[string]interface{}{"type": "test", "name": "expired"})
+
+	_, err := input.keeper.ProcessSetRecordAutoRenew(input.ctx, types.NewMsgSetRecordAutoRenew(string(expired.ID), false, ownerAddress))
+	require.NoError(t, err)
+
+	// Records renew by default, unless auto-renewal was turned off.
+	ctx := input.ctx.WithBlockTime(renewed.ExpiryTime.Add(time.Second))
+	input.keeper.ProcessRecordExpiryQueue(ctx)
+
+	renewedRecord := input.keeper.GetRecord(ctx, renewed.ID)
+	require.False(t, renewedRecord.Deleted)
+	require.Equal(t, renewed.ExpiryTime.Add(params.RecordRentDuration), renewedRecord.ExpiryTime)
+	require.True(t, input.keeper.GetRecord(ctx, expired.ID).Deleted)
+}
+
+func TestProcessDeleteRecordRefundsRentPaid(t *testing.T) {
+	input := createTestInput(t)
+	ownerKey, ownerAddress := input.createTestAccount(t, "1000000000uwire")
+
+	record := input.createTestRecord(t, ownerKey, ownerAddress, map[string]interface{}{"type": "test", "name": "refund"})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwire", 1000000)), record.RentPaid)
+	balance := input.bondKeeper.GetBond(input.ctx, record.BondID).Balance
+
+	// Raising the rent doesn't increase the refund beyond the rent paid.
+	params := input.keeper.GetParams(input.ctx)
+	params.RecordRent = "500000000uwire"
+	input.keeper.SetParams(input.ctx, params)
+
+	_, err := input.keeper.ProcessDeleteRecord(input.ctx, types.NewMsgDeleteRecord(string(record.ID), ownerAddress))
+	require.NoError(t, err)
+	require.Equal(t, balance.Add(record.RentPaid...), input.bondKeeper.GetBond(input.ctx, record.BondID).Balance)
+}
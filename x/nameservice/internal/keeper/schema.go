@@ -0,0 +1,192 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	wnsUtils "github.com/vulcanize/dxns/utils"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Generates record type -> RecordSchema index key.
+func getRecordSchemaIndexKey(recordType string) []byte {
+	return append(PrefixRecordTypeToSchemaIndex, []byte(recordType)...)
+}
+
+// HasRecordSchema - checks if a schema is registered for the record type.
+func (k Keeper) HasRecordSchema(ctx sdk.Context, recordType string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(getRecordSchemaIndexKey(recordType))
+}
+
+// GetRecordSchema - gets the schema registered for the record type.
+func (k Keeper) GetRecordSchema(ctx sdk.Context, recordType string) *types.RecordSchema {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(getRecordSchemaIndexKey(recordType))
+	if bz == nil {
+		return nil
+	}
+
+	var schema types.RecordSchema
+	k.cdc.MustUnmarshalBinaryBare(bz, &schema)
+
+	return &schema
+}
+
+// SetRecordSchema - saves the schema for a record type.
+func (k Keeper) SetRecordSchema(ctx sdk.Context, schema types.RecordSchema) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(getRecordSchemaIndexKey(schema.Type), k.cdc.MustMarshalBinaryBare(schema))
+}
+
+// ListRecordSchemas - get all record schemas.
+func (k Keeper) ListRecordSchemas(ctx sdk.Context) []types.RecordSchema {
+	var schemas []types.RecordSchema
+
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, PrefixRecordTypeToSchemaIndex)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		bz := store.Get(itr.Key())
+		if bz != nil {
+			var schema types.RecordSchema
+			k.cdc.MustUnmarshalBinaryBare(bz, &schema)
+			schemas = append(schemas, schema)
+		}
+	}
+
+	return schemas
+}
+
+// ProcessSetRecordSchema registers (or updates) the JSON Schema for a record type.
+func (k Keeper) ProcessSetRecordSchema(ctx sdk.Context, msg types.MsgSetRecordSchema) (*types.RecordSchema, error) {
+	name, err := types.NormalizeName(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.Authority = name
+
+	authority := k.GetNameAuthority(ctx, msg.Authority)
+	if authority == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name authority not found.")
+	}
+
+	if authority.OwnerAddress != msg.Signer.String() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	if authority.Status != types.AuthorityActive {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Authority is not active.")
+	}
+
+	// Record types are scoped to the authority (e.g. wrn://acme/app), so global types can't be claimed.
+	if !IsRecordTypeInAuthority(msg.RecordType, msg.Authority) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Record type must be under the authority, e.g. wrn://<authority>/<type>.")
+	}
+
+	if err := checkJSONSchema(msg.Schema); err != nil {
+		return nil, err
+	}
+
+	schema := types.RecordSchema{
+		Type:      msg.RecordType,
+		Authority: msg.Authority,
+		Schema:    msg.Schema,
+		Height:    ctx.BlockHeight(),
+	}
+
+	k.SetRecordSchema(ctx, schema)
+
+	return &schema, nil
+}
+
+// IsRecordTypeInAuthority returns true if the record type is a WRN under the (canonical) authority name.
+func IsRecordTypeInAuthority(recordType string, authority string) bool {
+	prefix := types.WRNScheme + authority + "/"
+	return strings.HasPrefix(recordType, prefix) && len(recordType) > len(prefix)
+}
+
+// validateRecordSchema checks the record attributes against the schema registered for the record type, if any.
+func (k Keeper) validateRecordSchema(ctx sdk.Context, record types.Record) error {
+	recordType, err := wnsUtils.GetAttributeAsString(record.Attributes, "type")
+	if err != nil {
+		// Records without a (string) type are not subject to schema validation.
+		return nil
+	}
+
+	schema := k.GetRecordSchema(ctx, recordType)
+	if schema == nil {
+		return nil
+	}
+
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewStringLoader(schema.Schema),
+		gojsonschema.NewGoLoader(record.Attributes),
+	)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Record schema validation failed.")
+	}
+
+	if !result.Valid() {
+		var errs []string
+		for _, resultErr := range result.Errors() {
+			errs = append(errs, resultErr.String())
+		}
+
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Record does not match schema for type %s: %s", recordType, strings.Join(errs, "; ")))
+	}
+
+	return nil
+}
+
+// checkJSONSchema checks that the schema is a valid, self-contained JSON Schema document.
+func checkJSONSchema(schema string) error {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(schema), &doc); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid schema JSON.")
+	}
+
+	// Remote references would make validation depend on network access, which isn't deterministic.
+	if hasRemoteRef(doc) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Schema must not contain remote references.")
+	}
+
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(doc)); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Invalid schema: %s", err.Error()))
+	}
+
+	return nil
+}
+
+func hasRemoteRef(node interface{}) bool {
+	switch value := node.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			if ref, ok := child.(string); ok && key == "$ref" && !strings.HasPrefix(ref, "#") {
+				return true
+			}
+
+			if hasRemoteRef(child) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, child := range value {
+			if hasRemoteRef(child) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
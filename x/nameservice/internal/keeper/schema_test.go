@@ -0,0 +1,40 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+const testSchema = `{"type": "object", "required": ["version"]}`
+
+func TestProcessSetRecordSchemaScopedToAuthority(t *testing.T) {
+	input := createTestInput(t)
+	ctx, k := input.ctx, input.keeper
+	_, owner := input.createTestAccount(t, "1000000uwire")
+	_, otherOwner := input.createTestAccount(t, "1000000uwire")
+
+	k.SetNameAuthority(ctx, "acme", types.NameAuthority{OwnerAddress: owner.String(), Status: types.AuthorityActive})
+	k.SetNameAuthority(ctx, "other", types.NameAuthority{OwnerAddress: otherOwner.String(), Status: types.AuthorityActive})
+
+	// Global and other authorities' types can't be claimed.
+	for _, recordType := range []string{"wrn:app", "wrn://other/app", "wrn://acme.other/app", "wrn://acme/"} {
+		_, err := k.ProcessSetRecordSchema(ctx, types.NewMsgSetRecordSchema(recordType, "acme", testSchema, owner))
+		require.Error(t, err, recordType)
+	}
+
+	schema, err := k.ProcessSetRecordSchema(ctx, types.NewMsgSetRecordSchema("wrn://acme/app", "ACME", testSchema, owner))
+	require.NoError(t, err)
+	require.Equal(t, "acme", schema.Authority)
+	require.Equal(t, *schema, *k.GetRecordSchema(ctx, "wrn://acme/app"))
+
+	// Only the authority owner can update the schema.
+	_, err = k.ProcessSetRecordSchema(ctx, types.NewMsgSetRecordSchema("wrn://acme/app", "acme", `{"type": "object"}`, otherOwner))
+	require.Error(t, err)
+	require.Equal(t, testSchema, k.GetRecordSchema(ctx, "wrn://acme/app").Schema)
+}
@@ -0,0 +1,151 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package keeper
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/go-amino"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	wnsUtils "github.com/vulcanize/dxns/utils"
+	"github.com/vulcanize/dxns/x/nameservice/internal/helpers"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+// LatestVersion is the version suffix that resolves to the highest version.
+const LatestVersion = "latest"
+
+// VersionAttributeName is the record attribute holding the (semver) version.
+const VersionAttributeName = "version"
+
+// NameAttributeName is the record attribute holding the name.
+const NameAttributeName = "name"
+
+// resolveVersionedWRN resolves a WRN to the record with the highest version matching the version range.
+// Candidates are the records the name has pointed to (latest and history), and the records owned by
+// the authority owner with a matching name attribute.
+func resolveVersionedWRN(store sdk.KVStore, codec *amino.Codec, wrn string, versionRange string) (*types.Record, *types.NameRecord) {
+	nameRecord := GetNameRecord(store, codec, wrn)
+
+	var constraint *semver.Constraints
+	if versionRange != LatestVersion {
+		var err error
+		constraint, err = semver.NewConstraint(versionRange)
+		if err != nil {
+			return nil, nameRecord
+		}
+	}
+
+	var bestRecord *types.Record
+	var bestVersion *semver.Version
+	for _, record := range getVersionCandidates(store, codec, wrn, nameRecord) {
+		versionAttr, ok := record.Attributes[VersionAttributeName].(string)
+		if !ok {
+			continue
+		}
+
+		version, err := semver.NewVersion(versionAttr)
+		if err != nil {
+			continue
+		}
+
+		if constraint != nil && !constraint.Check(version) {
+			continue
+		}
+
+		// On a tie, the name mapping wins over older mappings and name attribute matches.
+		if bestVersion == nil || version.GreaterThan(bestVersion) {
+			bestRecord = record
+			bestVersion = version
+		}
+	}
+
+	return bestRecord, nameRecord
+}
+
+func getVersionCandidates(store sdk.KVStore, codec *amino.Codec, wrn string, nameRecord *types.NameRecord) []*types.Record {
+	var records []*types.Record
+
+	parsedWRN, err := url.Parse(wrn)
+	if err != nil {
+		return records
+	}
+
+	authority := GetNameAuthority(store, codec, parsedWRN.Host)
+	if authority == nil {
+		return records
+	}
+
+	seen := make(map[types.ID]bool)
+	addCandidate := func(id types.ID) {
+		if id == "" || seen[id] || !HasRecord(store, id) {
+			return
+		}
+
+		seen[id] = true
+
+		record := GetRecord(store, codec, id)
+		if !record.Deleted {
+			records = append(records, &record)
+		}
+	}
+
+	if nameRecord != nil {
+		// Skip stale entries, i.e. set before the authority was (re-)registered.
+		entries := append([]types.NameRecordEntry{nameRecord.NameRecordEntry}, reverseNameRecordEntries(nameRecord.History)...)
+		for _, entry := range entries {
+			if entry.Height >= authority.Height {
+				addCandidate(entry.ID)
+			}
+		}
+	}
+
+	// Any account can publish a record with an arbitrary name attribute, so only trust records owned by the authority owner.
+	// Record owners are derived from public keys, so the authority owner public key is required.
+	if authority.OwnerPublicKey == "" {
+		return records
+	}
+
+	ownerPubKey, err := cryptoAmino.PubKeyFromBytes(helpers.BytesFromBase64(authority.OwnerPublicKey))
+	if err != nil {
+		return records
+	}
+
+	owner := helpers.GetAddressFromPubKey(ownerPubKey)
+	matchFn := func(record *types.Record) bool {
+		return isRecordOwner(record, owner)
+	}
+
+	// Name attribute may or may not have the wrn:// scheme.
+	for _, name := range []string{strings.TrimPrefix(wrn, "wrn://"), wrn} {
+		for _, record := range MatchIndexedRecords(store, codec, NameAttributeName, name, matchFn, wnsUtils.Pagination{}) {
+			addCandidate(record.ID)
+		}
+	}
+
+	return records
+}
+
+func reverseNameRecordEntries(entries []types.NameRecordEntry) []types.NameRecordEntry {
+	reversed := make([]types.NameRecordEntry, len(entries))
+	for index, entry := range entries {
+		reversed[len(entries)-1-index] = entry
+	}
+
+	return reversed
+}
+
+func isRecordOwner(record *types.Record, owner string) bool {
+	for _, recordOwner := range record.Owners {
+		if recordOwner == owner {
+			return true
+		}
+	}
+
+	return false
+}
@@ -21,14 +21,31 @@ func init() {
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSetRecord{}, "nameservice/SetRecord", nil)
 	cdc.RegisterConcrete(MsgRenewRecord{}, "nameservice/RenewRecord", nil)
+	cdc.RegisterConcrete(MsgDeleteRecord{}, "nameservice/DeleteRecord", nil)
+	cdc.RegisterConcrete(MsgSetRecordAutoRenew{}, "nameservice/SetRecordAutoRenew", nil)
+	cdc.RegisterConcrete(MsgSetRecordSchema{}, "nameservice/SetRecordSchema", nil)
 
 	cdc.RegisterConcrete(MsgReserveAuthority{}, "nameservice/ReserveAuthority", nil)
 	cdc.RegisterConcrete(MsgSetAuthorityBond{}, "nameservice/SetAuthorityBond", nil)
 	cdc.RegisterConcrete(MsgSetName{}, "nameservice/SetName", nil)
 	cdc.RegisterConcrete(MsgDeleteName{}, "nameservice/DeleteName", nil)
+	cdc.RegisterConcrete(MsgSetAlias{}, "nameservice/SetAlias", nil)
+	cdc.RegisterConcrete(MsgSetAuthorityPolicy{}, "nameservice/SetAuthorityPolicy", nil)
+	cdc.RegisterConcrete(MsgTransferAuthority{}, "nameservice/TransferAuthority", nil)
+	cdc.RegisterConcrete(MsgAcceptAuthority{}, "nameservice/AcceptAuthority", nil)
+	cdc.RegisterConcrete(MsgGrantNameAccess{}, "nameservice/GrantNameAccess", nil)
+	cdc.RegisterConcrete(MsgRevokeNameAccess{}, "nameservice/RevokeNameAccess", nil)
+	cdc.RegisterConcrete(MsgSetSubAuthorityPolicy{}, "nameservice/SetSubAuthorityPolicy", nil)
+	cdc.RegisterConcrete(MsgRenewAuthority{}, "nameservice/RenewAuthority", nil)
+	cdc.RegisterConcrete(MsgRedeemAuthority{}, "nameservice/RedeemAuthority", nil)
+	cdc.RegisterConcrete(MsgCommitAuthority{}, "nameservice/CommitAuthority", nil)
+	cdc.RegisterConcrete(MsgRevealAuthority{}, "nameservice/RevealAuthority", nil)
 
 	cdc.RegisterConcrete(MsgAssociateBond{}, "nameservice/AssociateBond", nil)
 	cdc.RegisterConcrete(MsgDissociateBond{}, "nameservice/DissociateBond", nil)
 	cdc.RegisterConcrete(MsgDissociateRecords{}, "nameservice/DissociateRecords", nil)
 	cdc.RegisterConcrete(MsgReassociateRecords{}, "nameservice/ReassociateRecords", nil)
+
+	cdc.RegisterConcrete(NameListChangeProposal{}, "nameservice/NameListChangeProposal", nil)
+	cdc.RegisterConcrete(AuthorityDisputeProposal{}, "nameservice/AuthorityDisputeProposal", nil)
 }
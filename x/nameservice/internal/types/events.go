@@ -0,0 +1,16 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+// nameservice module event types
+const (
+	EventTypeAuthorityDispute = "authority_dispute"
+
+	AttributeKeyAuthority = "authority"
+	AttributeKeyAction    = "action"
+	AttributeKeyNewOwner  = "new_owner"
+
+	AttributeValueCategory = ModuleName
+)
@@ -5,6 +5,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bond "github.com/vulcanize/dxns/x/bond"
@@ -18,6 +20,9 @@ type MsgSetRecord struct {
 	Payload PayloadObj     `json:"payload"`
 	BondID  bond.ID        `json:"bondId"`
 	Signer  sdk.AccAddress `json:"signer"`
+
+	// Number of owner approvals required for record mutations (0 => 1).
+	OwnerThreshold int64 `json:"ownerThreshold,omitempty"`
 }
 
 // NewMsgSetRecord is the constructor function for MsgSetRecord.
@@ -53,6 +58,10 @@ func (msg MsgSetRecord) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Bond ID is required.")
 	}
 
+	if msg.OwnerThreshold < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid owner threshold.")
+	}
+
 	return nil
 }
 
@@ -66,18 +75,35 @@ func (msg MsgSetRecord) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
 
+// MaxRecordRenewalPeriods is the max. number of rent periods a record can be paid for in advance.
+const MaxRecordRenewalPeriods = 10
+
 // MsgRenewRecord defines a renew record message.
 type MsgRenewRecord struct {
-	ID     ID             `json:"id"`
+	ID ID `json:"id"`
+
+	// Number of rent periods to pay for (0 => 1 period).
+	Periods int64 `json:"periods,omitempty"`
+
 	Signer sdk.AccAddress `json:"signer"`
 }
 
 // NewMsgRenewRecord is the constructor function for MsgRenewRecord.
-func NewMsgRenewRecord(id string, signer sdk.AccAddress) MsgRenewRecord {
+func NewMsgRenewRecord(id string, periods int64, signer sdk.AccAddress) MsgRenewRecord {
 	return MsgRenewRecord{
-		ID:     ID(id),
-		Signer: signer,
+		ID:      ID(id),
+		Periods: periods,
+		Signer:  signer,
+	}
+}
+
+// GetPeriods returns the number of rent periods to pay for.
+func (msg MsgRenewRecord) GetPeriods() int64 {
+	if msg.Periods == 0 {
+		return 1
 	}
+
+	return msg.Periods
 }
 
 // Route Implements Msg.
@@ -96,6 +122,10 @@ func (msg MsgRenewRecord) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Record ID is required.")
 	}
 
+	if msg.Periods < 0 || msg.Periods > MaxRecordRenewalPeriods {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Periods should be between 1 and %d.", MaxRecordRenewalPeriods))
+	}
+
 	return nil
 }
 
@@ -108,3 +138,150 @@ func (msg MsgRenewRecord) GetSignBytes() []byte {
 func (msg MsgRenewRecord) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
+
+// MsgSetRecordAutoRenew defines a message to turn record auto-renewal on/off.
+type MsgSetRecordAutoRenew struct {
+	ID        ID             `json:"id"`
+	AutoRenew bool           `json:"autoRenew"`
+	Signer    sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgSetRecordAutoRenew is the constructor function for MsgSetRecordAutoRenew.
+func NewMsgSetRecordAutoRenew(id string, autoRenew bool, signer sdk.AccAddress) MsgSetRecordAutoRenew {
+	return MsgSetRecordAutoRenew{
+		ID:        ID(id),
+		AutoRenew: autoRenew,
+		Signer:    signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetRecordAutoRenew) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetRecordAutoRenew) Type() string { return "set-record-auto-renew" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetRecordAutoRenew) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can't be empty")
+	}
+
+	if msg.ID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Record ID is required.")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetRecordAutoRenew) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetRecordAutoRenew) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgDeleteRecord defines a delete record message.
+type MsgDeleteRecord struct {
+	ID     ID             `json:"id"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgDeleteRecord is the constructor function for MsgDeleteRecord.
+func NewMsgDeleteRecord(id string, signer sdk.AccAddress) MsgDeleteRecord {
+	return MsgDeleteRecord{
+		ID:     ID(id),
+		Signer: signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgDeleteRecord) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgDeleteRecord) Type() string { return "delete-record" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgDeleteRecord) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can't be empty")
+	}
+
+	if msg.ID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Record ID is required.")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgDeleteRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgDeleteRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgSetRecordSchema defines a set record schema message.
+type MsgSetRecordSchema struct {
+	RecordType string         `json:"recordType"`
+	Authority  string         `json:"authority"`
+	Schema     string         `json:"schema"`
+	Signer     sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgSetRecordSchema is the constructor function for MsgSetRecordSchema.
+func NewMsgSetRecordSchema(recordType string, authority string, schema string, signer sdk.AccAddress) MsgSetRecordSchema {
+	return MsgSetRecordSchema{
+		RecordType: recordType,
+		Authority:  authority,
+		Schema:     schema,
+		Signer:     signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetRecordSchema) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetRecordSchema) Type() string { return "set-record-schema" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetRecordSchema) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can't be empty")
+	}
+
+	if msg.RecordType == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Record type is required.")
+	}
+
+	if msg.Authority == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Authority is required.")
+	}
+
+	if _, err := NormalizeName(msg.Authority); err != nil {
+		return err
+	}
+
+	if msg.Schema == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Schema is required.")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetRecordSchema) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetRecordSchema) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
@@ -227,6 +227,10 @@ func (msg MsgSetAuthorityBond) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is required.")
 	}
 
+	if _, err := NormalizeName(msg.Name); err != nil {
+		return err
+	}
+
 	if msg.BondID == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Bond ID is required.")
 	}
@@ -5,6 +5,10 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -40,6 +44,10 @@ func (msg MsgReserveAuthority) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is required.")
 	}
 
+	if _, err := NormalizeName(msg.Name); err != nil {
+		return err
+	}
+
 	if msg.Signer.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
 	}
@@ -86,6 +94,14 @@ func (msg MsgSetName) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "WRN is required.")
 	}
 
+	if _, err := NormalizeWRN(msg.WRN); err != nil {
+		return err
+	}
+
+	if _, _, found := ParseVersionedWRN(msg.WRN); found {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name can't have a version suffix.")
+	}
+
 	if msg.ID == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "ID is required.")
 	}
@@ -107,6 +123,64 @@ func (msg MsgSetName) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
 
+// MsgSetAlias defines a message to point a name at another WRN.
+type MsgSetAlias struct {
+	WRN    string         `json:"wrn"`
+	Target string         `json:"target"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgSetAlias is the constructor function for MsgSetAlias.
+func NewMsgSetAlias(wrn string, target string, signer sdk.AccAddress) MsgSetAlias {
+	return MsgSetAlias{
+		WRN:    wrn,
+		Target: target,
+		Signer: signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetAlias) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetAlias) Type() string { return "set-alias" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetAlias) ValidateBasic() error {
+
+	if msg.WRN == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "WRN is required.")
+	}
+
+	if _, err := NormalizeWRN(msg.WRN); err != nil {
+		return err
+	}
+
+	if msg.Target == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Target WRN is required.")
+	}
+
+	if _, err := NormalizeWRN(msg.Target); err != nil {
+		return err
+	}
+
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetAlias) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetAlias) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
 // MsgDeleteName defines a DeleteName message.
 type MsgDeleteName struct {
 	WRN    string         `json:"wrn"`
@@ -134,6 +208,10 @@ func (msg MsgDeleteName) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "WRN is required.")
 	}
 
+	if _, err := NormalizeWRN(msg.WRN); err != nil {
+		return err
+	}
+
 	if msg.Signer.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
 	}
@@ -150,3 +228,580 @@ func (msg MsgDeleteName) GetSignBytes() []byte {
 func (msg MsgDeleteName) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Signer}
 }
+
+// MsgSetAuthorityPolicy defines a message to set the M-of-N owner policy for an authority.
+type MsgSetAuthorityPolicy struct {
+	Name      string         `json:"name"`
+	Owners    []string       `json:"owners"`
+	Threshold int64          `json:"threshold"`
+	Signer    sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgSetAuthorityPolicy is the constructor function for MsgSetAuthorityPolicy.
+func NewMsgSetAuthorityPolicy(name string, owners []string, threshold int64, signer sdk.AccAddress) MsgSetAuthorityPolicy {
+	return MsgSetAuthorityPolicy{
+		Name:      name,
+		Owners:    owners,
+		Threshold: threshold,
+		Signer:    signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetAuthorityPolicy) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetAuthorityPolicy) Type() string { return "set-authority-policy" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetAuthorityPolicy) ValidateBasic() error {
+
+	if msg.Name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is required.")
+	}
+
+	if _, err := NormalizeName(msg.Name); err != nil {
+		return err
+	}
+
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
+	}
+
+	// Threshold 0 (without owners) clears the policy.
+	if msg.Threshold < 0 || msg.Threshold > int64(len(msg.Owners)) || (msg.Threshold == 0 && len(msg.Owners) > 0) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid threshold.")
+	}
+
+	owners := make(map[string]bool)
+	for _, owner := range msg.Owners {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid owner address.")
+		}
+
+		if owners[owner] {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Duplicate owner address.")
+		}
+
+		owners[owner] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetAuthorityPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetAuthorityPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgTransferAuthority defines a message to transfer ownership of an authority.
+type MsgTransferAuthority struct {
+	Name     string         `json:"name"`
+	NewOwner sdk.AccAddress `json:"newOwner"`
+
+	// If set, the new owner has to accept the transfer (see MsgAcceptAuthority).
+	TwoStep bool `json:"twoStep,omitempty"`
+
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgTransferAuthority is the constructor function for MsgTransferAuthority.
+func NewMsgTransferAuthority(name string, newOwner sdk.AccAddress, twoStep bool, signer sdk.AccAddress) MsgTransferAuthority {
+	return MsgTransferAuthority{
+		Name:     name,
+		NewOwner: newOwner,
+		TwoStep:  twoStep,
+		Signer:   signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgTransferAuthority) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgTransferAuthority) Type() string { return "transfer-authority" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgTransferAuthority) ValidateBasic() error {
+
+	if msg.Name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is required.")
+	}
+
+	if _, err := NormalizeName(msg.Name); err != nil {
+		return err
+	}
+
+	if msg.NewOwner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "New owner is required.")
+	}
+
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTransferAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTransferAuthority) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgAcceptAuthority defines a message to accept a pending (two-step) authority transfer.
+type MsgAcceptAuthority struct {
+	Name   string         `json:"name"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgAcceptAuthority is the constructor function for MsgAcceptAuthority.
+func NewMsgAcceptAuthority(name string, signer sdk.AccAddress) MsgAcceptAuthority {
+	return MsgAcceptAuthority{
+		Name:   name,
+		Signer: signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgAcceptAuthority) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgAcceptAuthority) Type() string { return "accept-authority" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgAcceptAuthority) ValidateBasic() error {
+
+	if msg.Name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is required.")
+	}
+
+	if _, err := NormalizeName(msg.Name); err != nil {
+		return err
+	}
+
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgAcceptAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgAcceptAuthority) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgGrantNameAccess defines a message to grant (scoped) write access to names under an authority.
+type MsgGrantNameAccess struct {
+	Pattern     string         `json:"pattern"`
+	Grantee     sdk.AccAddress `json:"grantee"`
+	Permissions []string       `json:"permissions"`
+	ExpiryTime  time.Time      `json:"expiryTime,omitempty"`
+	Signer      sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgGrantNameAccess is the constructor function for MsgGrantNameAccess.
+func NewMsgGrantNameAccess(pattern string, grantee sdk.AccAddress, permissions []string, expiryTime time.Time, signer sdk.AccAddress) MsgGrantNameAccess {
+	return MsgGrantNameAccess{
+		Pattern:     pattern,
+		Grantee:     grantee,
+		Permissions: permissions,
+		ExpiryTime:  expiryTime,
+		Signer:      signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgGrantNameAccess) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgGrantNameAccess) Type() string { return "grant-name-access" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgGrantNameAccess) ValidateBasic() error {
+
+	if !strings.HasPrefix(msg.Pattern, "wrn://") {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid WRN pattern.")
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Grantee is required.")
+	}
+
+	if len(msg.Permissions) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Permissions are required.")
+	}
+
+	for _, permission := range msg.Permissions {
+		if permission != PermissionSetName && permission != PermissionDeleteName && permission != PermissionSetAlias {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Invalid permission: %s", permission))
+		}
+	}
+
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGrantNameAccess) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGrantNameAccess) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgRevokeNameAccess defines a message to revoke a grant.
+type MsgRevokeNameAccess struct {
+	Pattern string         `json:"pattern"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	Signer  sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgRevokeNameAccess is the constructor function for MsgRevokeNameAccess.
+func NewMsgRevokeNameAccess(pattern string, grantee sdk.AccAddress, signer sdk.AccAddress) MsgRevokeNameAccess {
+	return MsgRevokeNameAccess{
+		Pattern: pattern,
+		Grantee: grantee,
+		Signer:  signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRevokeNameAccess) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRevokeNameAccess) Type() string { return "revoke-name-access" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevokeNameAccess) ValidateBasic() error {
+
+	if !strings.HasPrefix(msg.Pattern, "wrn://") {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid WRN pattern.")
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Grantee is required.")
+	}
+
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeNameAccess) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeNameAccess) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgSetSubAuthorityPolicy defines a message to set the sub-authority issuance policy for an authority.
+type MsgSetSubAuthorityPolicy struct {
+	Name      string         `json:"name"`
+	Open      bool           `json:"open,omitempty"`
+	AllowList []string       `json:"allowList,omitempty"`
+	Fee       sdk.Coins      `json:"fee,omitempty"`
+	MaxDepth  int64          `json:"maxDepth,omitempty"`
+	Signer    sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgSetSubAuthorityPolicy is the constructor function for MsgSetSubAuthorityPolicy.
+func NewMsgSetSubAuthorityPolicy(name string, policy SubAuthorityPolicy, signer sdk.AccAddress) MsgSetSubAuthorityPolicy {
+	return MsgSetSubAuthorityPolicy{
+		Name:      name,
+		Open:      policy.Open,
+		AllowList: policy.AllowList,
+		Fee:       policy.Fee,
+		MaxDepth:  policy.MaxDepth,
+		Signer:    signer,
+	}
+}
+
+// Policy returns the sub-authority policy to set.
+func (msg MsgSetSubAuthorityPolicy) Policy() SubAuthorityPolicy {
+	return SubAuthorityPolicy{
+		Open:      msg.Open,
+		AllowList: msg.AllowList,
+		Fee:       msg.Fee,
+		MaxDepth:  msg.MaxDepth,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetSubAuthorityPolicy) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetSubAuthorityPolicy) Type() string { return "set-sub-authority-policy" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetSubAuthorityPolicy) ValidateBasic() error {
+
+	if msg.Name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is required.")
+	}
+
+	if _, err := NormalizeName(msg.Name); err != nil {
+		return err
+	}
+
+	for _, address := range msg.AllowList {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid allow list address.")
+		}
+	}
+
+	if !msg.Fee.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Invalid fee.")
+	}
+
+	if msg.MaxDepth < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid max. depth.")
+	}
+
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetSubAuthorityPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetSubAuthorityPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MaxAuthorityRenewalPeriods is the max. number of rent periods an authority can be paid for in advance.
+const MaxAuthorityRenewalPeriods = 10
+
+// MsgRenewAuthority defines a message to prepay authority rent.
+type MsgRenewAuthority struct {
+	Name string `json:"name"`
+
+	// Number of rent periods to pay for (0 => 1 period).
+	Periods int64 `json:"periods,omitempty"`
+
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgRenewAuthority is the constructor function for MsgRenewAuthority.
+func NewMsgRenewAuthority(name string, periods int64, signer sdk.AccAddress) MsgRenewAuthority {
+	return MsgRenewAuthority{
+		Name:    name,
+		Periods: periods,
+		Signer:  signer,
+	}
+}
+
+// GetPeriods returns the number of rent periods to pay for.
+func (msg MsgRenewAuthority) GetPeriods() int64 {
+	if msg.Periods == 0 {
+		return 1
+	}
+
+	return msg.Periods
+}
+
+// Route Implements Msg.
+func (msg MsgRenewAuthority) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRenewAuthority) Type() string { return "renew-authority" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRenewAuthority) ValidateBasic() error {
+
+	if msg.Name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is required.")
+	}
+
+	if _, err := NormalizeName(msg.Name); err != nil {
+		return err
+	}
+
+	if msg.Periods < 0 || msg.Periods > MaxAuthorityRenewalPeriods {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Periods should be between 1 and %d.", MaxAuthorityRenewalPeriods))
+	}
+
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRenewAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRenewAuthority) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgRedeemAuthority defines a message to restore an authority during its redemption period.
+type MsgRedeemAuthority struct {
+	Name   string         `json:"name"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgRedeemAuthority is the constructor function for MsgRedeemAuthority.
+func NewMsgRedeemAuthority(name string, signer sdk.AccAddress) MsgRedeemAuthority {
+	return MsgRedeemAuthority{
+		Name:   name,
+		Signer: signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRedeemAuthority) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRedeemAuthority) Type() string { return "redeem-authority" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRedeemAuthority) ValidateBasic() error {
+
+	if msg.Name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Name is required.")
+	}
+
+	if _, err := NormalizeName(msg.Name); err != nil {
+		return err
+	}
+
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRedeemAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRedeemAuthority) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgCommitAuthority defines a message to commit to (i.e. hide) a root authority reservation.
+type MsgCommitAuthority struct {
+	CommitHash string         `json:"commit"`
+	Signer     sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgCommitAuthority is the constructor function for MsgCommitAuthority.
+func NewMsgCommitAuthority(commitHash string, signer sdk.AccAddress) MsgCommitAuthority {
+	return MsgCommitAuthority{
+		CommitHash: commitHash,
+		Signer:     signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCommitAuthority) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCommitAuthority) Type() string { return "commit-authority" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCommitAuthority) ValidateBasic() error {
+
+	if msg.CommitHash == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Commit hash is required.")
+	}
+
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCommitAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCommitAuthority) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgRevealAuthority defines a message to reveal a committed root authority reservation.
+type MsgRevealAuthority struct {
+	// Hex encoded reveal JSON.
+	Reveal string         `json:"reveal"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgRevealAuthority is the constructor function for MsgRevealAuthority.
+func NewMsgRevealAuthority(reveal string, signer sdk.AccAddress) MsgRevealAuthority {
+	return MsgRevealAuthority{
+		Reveal: reveal,
+		Signer: signer,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRevealAuthority) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRevealAuthority) Type() string { return "reveal-authority" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevealAuthority) ValidateBasic() error {
+
+	if msg.Reveal == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Reveal is required.")
+	}
+
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevealAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevealAuthority) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
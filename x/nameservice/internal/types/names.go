@@ -0,0 +1,111 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/net/idna"
+)
+
+// WRNScheme is the scheme prefix of WRNs.
+const WRNScheme = "wrn://"
+
+// VersionSeparator separates a WRN from its version (range) suffix, e.g. wrn://authority/app@^1.2.0.
+const VersionSeparator = "@"
+
+// nameProfile implements UTS-46 (non-transitional) processing for authority names, i.e.
+// case folding/mapping, STD3 (letter, digit, hyphen) label rules, bidi rules and DNS length limits.
+var nameProfile = idna.New(
+	idna.MapForLookup(),
+	idna.Transitional(false),
+	idna.BidiRule(),
+	idna.VerifyDNSLength(true),
+)
+
+// Scripts with look-alike letters, which can't be mixed in a label.
+var confusableScripts = []*unicode.RangeTable{unicode.Latin, unicode.Greek, unicode.Cyrillic}
+
+// NormalizeName returns the canonical (punycode) form of an authority name.
+func NormalizeName(name string) (string, error) {
+	asciiName, err := nameProfile.ToASCII(name)
+	if err != nil {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Invalid name: %s", err.Error()))
+	}
+
+	unicodeName, err := nameProfile.ToUnicode(asciiName)
+	if err != nil {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Invalid name: %s", err.Error()))
+	}
+
+	for _, label := range strings.Split(unicodeName, ".") {
+		if label == "" {
+			return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid name: empty label.")
+		}
+
+		if hasMixedScripts(label) {
+			return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Invalid name: mixed scripts in label %s.", label))
+		}
+	}
+
+	return asciiName, nil
+}
+
+// IsCanonicalName returns true if the name is valid and already in its canonical form.
+func IsCanonicalName(name string) bool {
+	normalized, err := NormalizeName(name)
+	return err == nil && normalized == name
+}
+
+// NormalizeWRN returns the canonical form of a WRN, i.e. with the authority name normalized.
+// The path is case-sensitive, and is left as is.
+func NormalizeWRN(wrn string) (string, error) {
+	parsedWRN, err := url.Parse(wrn)
+	if err != nil || !strings.HasPrefix(wrn, WRNScheme+parsedWRN.Host) {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid WRN.")
+	}
+
+	name, err := NormalizeName(parsedWRN.Host)
+	if err != nil {
+		return "", err
+	}
+
+	return WRNScheme + name + wrn[len(WRNScheme)+len(parsedWRN.Host):], nil
+}
+
+// ParseVersionedWRN splits a WRN into the base WRN and the version (range) suffix, if any.
+func ParseVersionedWRN(wrn string) (string, string, bool) {
+	index := strings.LastIndex(wrn, VersionSeparator)
+
+	// Version suffix is only allowed in the path, i.e. after the authority.
+	if index < 0 || !strings.Contains(strings.TrimPrefix(wrn[:index], WRNScheme), "/") {
+		return wrn, "", false
+	}
+
+	return wrn[:index], wrn[index+len(VersionSeparator):], true
+}
+
+func hasMixedScripts(label string) bool {
+	var labelScript *unicode.RangeTable
+	for _, r := range label {
+		for _, script := range confusableScripts {
+			if !unicode.Is(script, r) {
+				continue
+			}
+
+			if labelScript != nil && labelScript != script {
+				return true
+			}
+
+			labelScript = script
+		}
+	}
+
+	return false
+}
@@ -7,11 +7,14 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/vulcanize/dxns/x/auction"
 )
 
 // Default parameter namespace.
@@ -31,12 +34,20 @@ const (
 	DefaultAuthorityExpiryTime  time.Duration = time.Hour * 24 * 365
 	DefaultAuthorityGracePeriod time.Duration = time.Hour * 24 * 2
 
+	DefaultAuthorityRedemptionPeriod  time.Duration = time.Hour * 24 * 30
+	DefaultAuthorityRedemptionPenalty string        = "5000000uwire"
+
+	DefaultAuthorityCommitRevealEnabled               = false
+	DefaultAuthorityRevealDelay         time.Duration = time.Minute
+	DefaultAuthorityRevealWindow        time.Duration = time.Hour * 24
+
 	DefaultAuthorityAuctionEnabled               = false
 	DefaultCommitsDuration         time.Duration = time.Hour * 24
 	DefaultRevealsDuration         time.Duration = time.Hour * 24
 	DefaultCommitFee               string        = "1000000uwire"
 	DefaultRevealFee               string        = "1000000uwire"
 	DefaultMinimumBid              string        = "5000000uwire"
+	DefaultAuctionProceeds         string        = ""
 )
 
 // Keys for parameter access
@@ -48,16 +59,47 @@ var (
 	KeyAuthorityRentDuration = []byte("AuthorityRentDuration")
 	KeyAuthorityGracePeriod  = []byte("AuthorityGracePeriod")
 
+	KeyAuthorityRedemptionPeriod  = []byte("AuthorityRedemptionPeriod")
+	KeyAuthorityRedemptionPenalty = []byte("AuthorityRedemptionPenalty")
+
+	KeyAuthorityLengthPrices  = []byte("AuthorityLengthPrices")
+	KeyAuthorityPremiumPrices = []byte("AuthorityPremiumPrices")
+
+	KeyAuthorityCommitRevealEnabled = []byte("AuthorityCommitRevealEnabled")
+	KeyAuthorityRevealDelay         = []byte("AuthorityRevealDelay")
+	KeyAuthorityRevealWindow        = []byte("AuthorityRevealWindow")
+
 	KeyAuthorityAuctionEnabled = []byte("AuthorityAuctionEnabled")
 	KeyCommitsDuration         = []byte("AuthorityAuctionCommitsDuration")
 	KeyRevealsDuration         = []byte("AuthorityAuctionRevealsDuration")
 	KeyCommitFee               = []byte("AuthorityAuctionCommitFee")
 	KeyRevealFee               = []byte("AuthorityAuctionRevealFee")
 	KeyMinimumBid              = []byte("AuthorityAuctionMinimumBid")
+	KeyAuctionProceeds         = []byte("AuthorityAuctionProceeds")
 )
 
 var _ subspace.ParamSet = &Params{}
 
+// AuthorityLengthPrice is the price of root authorities with names up to a max. length.
+type AuthorityLengthPrice struct {
+	MaxLength int64 `json:"max_length" yaml:"max_length"`
+
+	Rent string `json:"rent" yaml:"rent"`
+
+	// Auction minimum bid (empty => MinimumBid param).
+	MinimumBid string `json:"minimum_bid,omitempty" yaml:"minimum_bid,omitempty"`
+}
+
+// AuthorityPremiumPrice is the price of a premium root authority name.
+type AuthorityPremiumPrice struct {
+	Name string `json:"name" yaml:"name"`
+
+	Rent string `json:"rent" yaml:"rent"`
+
+	// Auction minimum bid (empty => MinimumBid param).
+	MinimumBid string `json:"minimum_bid,omitempty" yaml:"minimum_bid,omitempty"`
+}
+
 // Params defines the high level settings for the nameservice module.
 type Params struct {
 	RecordRent         string        `json:"record_rent" yaml:"record_rent"`
@@ -67,6 +109,20 @@ type Params struct {
 	AuthorityRentDuration time.Duration `json:"authority_rent_duration" yaml:"authority_rent_duration"`
 	AuthorityGracePeriod  time.Duration `json:"authority_grace_period" yaml:"authority_grace_period"`
 
+	// Window after expiry during which only the previous owner can restore the authority (0 => no redemption period).
+	AuthorityRedemptionPeriod  time.Duration `json:"authority_redemption_period" yaml:"authority_redemption_period"`
+	AuthorityRedemptionPenalty string        `json:"authority_redemption_penalty" yaml:"authority_redemption_penalty"`
+
+	// Root authority pricing, by name length and premium names (overrides AuthorityRent and MinimumBid).
+	AuthorityLengthPrices  []AuthorityLengthPrice  `json:"authority_length_prices" yaml:"authority_length_prices"`
+	AuthorityPremiumPrices []AuthorityPremiumPrice `json:"authority_premium_prices" yaml:"authority_premium_prices"`
+
+	// Must root authorities be reserved using commit/reveal (if name auctions are disabled)?
+	// Reveals are accepted from RevealDelay to RevealWindow after the commit.
+	AuthorityCommitRevealEnabled bool          `json:"authority_commit_reveal_enabled" yaml:"authority_commit_reveal_enabled"`
+	AuthorityRevealDelay         time.Duration `json:"authority_reveal_delay" yaml:"authority_reveal_delay"`
+	AuthorityRevealWindow        time.Duration `json:"authority_reveal_window" yaml:"authority_reveal_window"`
+
 	// Are name auctions enabled?
 	AuthorityAuctionEnabled bool          `json:"authority_auction_enabled" yaml:"authority_auction_enabled"`
 	CommitsDuration         time.Duration `json:"authority_auction_commits_duration" yaml:"authority_auction_commits_duration"`
@@ -74,13 +130,19 @@ type Params struct {
 	CommitFee               string        `json:"authority_auction_commit_fee" yaml:"authority_auction_commit_fee"`
 	RevealFee               string        `json:"authority_auction_reveal_fee" yaml:"authority_auction_reveal_fee"`
 	MinimumBid              string        `json:"authority_auction_minimum_bid" yaml:"authority_auction_minimum_bid"`
+
+	// Distribution of the name auction winner price, e.g. "burn:50,community_pool:50" (empty => burn anything over the min. bid).
+	AuctionProceeds string `json:"authority_auction_proceeds" yaml:"authority_auction_proceeds"`
 }
 
 // NewParams creates a new Params instance
 func NewParams(recordRent string, recordRentDuration time.Duration,
 	authorityRent string, authorityRentDuration time.Duration, authorityGracePeriod time.Duration,
+	authorityRedemptionPeriod time.Duration, authorityRedemptionPenalty string,
+	authorityLengthPrices []AuthorityLengthPrice, authorityPremiumPrices []AuthorityPremiumPrice,
+	authorityCommitRevealEnabled bool, authorityRevealDelay time.Duration, authorityRevealWindow time.Duration,
 	authorityAuctionEnabled bool, commitsDuration time.Duration, revealsDuration time.Duration,
-	commitFee string, revealFee string, minimumBid string) Params {
+	commitFee string, revealFee string, minimumBid string, auctionProceeds string) Params {
 
 	return Params{
 		RecordRent:         recordRent,
@@ -90,12 +152,24 @@ func NewParams(recordRent string, recordRentDuration time.Duration,
 		AuthorityRentDuration: authorityRentDuration,
 		AuthorityGracePeriod:  authorityGracePeriod,
 
+		AuthorityRedemptionPeriod:  authorityRedemptionPeriod,
+		AuthorityRedemptionPenalty: authorityRedemptionPenalty,
+
+		AuthorityLengthPrices:  authorityLengthPrices,
+		AuthorityPremiumPrices: authorityPremiumPrices,
+
+		AuthorityCommitRevealEnabled: authorityCommitRevealEnabled,
+		AuthorityRevealDelay:         authorityRevealDelay,
+		AuthorityRevealWindow:        authorityRevealWindow,
+
 		AuthorityAuctionEnabled: authorityAuctionEnabled,
 		CommitsDuration:         commitsDuration,
 		RevealsDuration:         revealsDuration,
 		CommitFee:               commitFee,
 		RevealFee:               revealFee,
 		MinimumBid:              minimumBid,
+
+		AuctionProceeds: auctionProceeds,
 	}
 }
 
@@ -114,12 +188,23 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		params.NewParamSetPair(KeyAuthorityRentDuration, &p.AuthorityRentDuration, validateAuthorityRentDuration),
 		params.NewParamSetPair(KeyAuthorityGracePeriod, &p.AuthorityGracePeriod, validateAuthorityGracePeriod),
 
+		params.NewParamSetPair(KeyAuthorityRedemptionPeriod, &p.AuthorityRedemptionPeriod, validateAuthorityRedemptionPeriod),
+		params.NewParamSetPair(KeyAuthorityRedemptionPenalty, &p.AuthorityRedemptionPenalty, validateAuthorityRedemptionPenalty),
+
+		params.NewParamSetPair(KeyAuthorityLengthPrices, &p.AuthorityLengthPrices, validateAuthorityLengthPrices),
+		params.NewParamSetPair(KeyAuthorityPremiumPrices, &p.AuthorityPremiumPrices, validateAuthorityPremiumPrices),
+
+		params.NewParamSetPair(KeyAuthorityCommitRevealEnabled, &p.AuthorityCommitRevealEnabled, validateAuthorityCommitRevealEnabled),
+		params.NewParamSetPair(KeyAuthorityRevealDelay, &p.AuthorityRevealDelay, validateAuthorityRevealDelay),
+		params.NewParamSetPair(KeyAuthorityRevealWindow, &p.AuthorityRevealWindow, validateAuthorityRevealWindow),
+
 		params.NewParamSetPair(KeyAuthorityAuctionEnabled, &p.AuthorityAuctionEnabled, validateAuthorityAuctionEnabled),
 		params.NewParamSetPair(KeyCommitsDuration, &p.CommitsDuration, validateCommitsDuration),
 		params.NewParamSetPair(KeyRevealsDuration, &p.RevealsDuration, validateRevealsDuration),
 		params.NewParamSetPair(KeyCommitFee, &p.CommitFee, validateCommitFee),
 		params.NewParamSetPair(KeyRevealFee, &p.RevealFee, validateRevealFee),
 		params.NewParamSetPair(KeyMinimumBid, &p.MinimumBid, validateMinimumBid),
+		params.NewParamSetPair(KeyAuctionProceeds, &p.AuctionProceeds, validateAuctionProceeds),
 	}
 }
 
@@ -134,8 +219,11 @@ func (p Params) Equal(p2 Params) bool {
 func DefaultParams() Params {
 	return NewParams(DefaultRecordRent, DefaultRecordExpiryTime,
 		DefaultAuthorityRent, DefaultAuthorityExpiryTime, DefaultAuthorityGracePeriod,
+		DefaultAuthorityRedemptionPeriod, DefaultAuthorityRedemptionPenalty,
+		[]AuthorityLengthPrice{}, []AuthorityPremiumPrice{},
+		DefaultAuthorityCommitRevealEnabled, DefaultAuthorityRevealDelay, DefaultAuthorityRevealWindow,
 		DefaultAuthorityAuctionEnabled, DefaultCommitsDuration, DefaultRevealsDuration,
-		DefaultCommitFee, DefaultRevealFee, DefaultMinimumBid,
+		DefaultCommitFee, DefaultRevealFee, DefaultMinimumBid, DefaultAuctionProceeds,
 	)
 }
 
@@ -149,15 +237,30 @@ func (p Params) String() string {
   Authority Rent Duration         : %v
   Authority Grace Period          : %v
 
+  Authority Redemption Period     : %v
+  Authority Redemption Penalty    : %v
+
+  Authority Length Prices         : %v
+  Authority Premium Prices        : %v
+
+  Authority Commit/Reveal Enabled : %v
+  Authority Reveal Delay          : %v
+  Authority Reveal Window         : %v
+
   Authority Auction Enabled          : %v
   Authority Auction Commits Duration : %v
   Authority Auction Reveals Duration : %v
   Authority Auction Commit Fee       : %v
   Authority Auction Reveal Fee       : %v
-  Authority Auction Minimum Bid      : %v`,
+  Authority Auction Minimum Bid      : %v
+  Authority Auction Proceeds         : %v`,
 		p.RecordRent, p.RecordRentDuration,
 		p.AuthorityRent, p.AuthorityRentDuration, p.AuthorityGracePeriod,
-		p.AuthorityAuctionEnabled, p.CommitsDuration, p.RevealsDuration, p.CommitFee, p.RevealFee, p.MinimumBid)
+		p.AuthorityRedemptionPeriod, p.AuthorityRedemptionPenalty,
+		p.AuthorityLengthPrices, p.AuthorityPremiumPrices,
+		p.AuthorityCommitRevealEnabled, p.AuthorityRevealDelay, p.AuthorityRevealWindow,
+		p.AuthorityAuctionEnabled, p.CommitsDuration, p.RevealsDuration, p.CommitFee, p.RevealFee, p.MinimumBid,
+		p.AuctionProceeds)
 }
 
 func validateAmount(name string, i interface{}) error {
@@ -215,6 +318,128 @@ func validateAuthorityGracePeriod(i interface{}) error {
 	return validateDuration("AuthorityGracePeriod", i)
 }
 
+func validateAuthorityRedemptionPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", "AuthorityRedemptionPeriod", i)
+	}
+
+	// Zero disables the redemption period.
+	if v < 0 {
+		return fmt.Errorf("%s can't be negative", "AuthorityRedemptionPeriod")
+	}
+
+	return nil
+}
+
+func validateAuthorityRedemptionPenalty(i interface{}) error {
+	return validateAmount("AuthorityRedemptionPenalty", i)
+}
+
+func validateAuthorityLengthPrices(i interface{}) error {
+	v, ok := i.([]AuthorityLengthPrice)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", "AuthorityLengthPrices", i)
+	}
+
+	lengths := make(map[int64]bool)
+	for _, price := range v {
+		if price.MaxLength <= 0 {
+			return fmt.Errorf("%s max. length must be a positive integer", "AuthorityLengthPrices")
+		}
+
+		if lengths[price.MaxLength] {
+			return fmt.Errorf("%s duplicate max. length: %d", "AuthorityLengthPrices", price.MaxLength)
+		}
+
+		lengths[price.MaxLength] = true
+
+		if err := validatePrice("AuthorityLengthPrices", price.Rent, price.MinimumBid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateAuthorityPremiumPrices(i interface{}) error {
+	v, ok := i.([]AuthorityPremiumPrice)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", "AuthorityPremiumPrices", i)
+	}
+
+	names := make(map[string]bool)
+	for _, price := range v {
+		if price.Name == "" {
+			return fmt.Errorf("%s name can't be an empty string", "AuthorityPremiumPrices")
+		}
+
+		// Premium names are matched against normalized authority names.
+		normalized, err := NormalizeName(price.Name)
+		if err != nil {
+			return fmt.Errorf("%s invalid name: %s", "AuthorityPremiumPrices", price.Name)
+		}
+
+		if normalized != price.Name {
+			return fmt.Errorf("%s name must be in canonical form: %s (not %s)", "AuthorityPremiumPrices", normalized, price.Name)
+		}
+
+		if names[price.Name] {
+			return fmt.Errorf("%s duplicate name: %s", "AuthorityPremiumPrices", price.Name)
+		}
+
+		names[price.Name] = true
+
+		if err := validatePrice("AuthorityPremiumPrices", price.Rent, price.MinimumBid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validatePrice(name string, rent string, minimumBid string) error {
+	if err := validateAmount(name, rent); err != nil {
+		return err
+	}
+
+	if minimumBid == "" {
+		return nil
+	}
+
+	if _, err := sdk.ParseCoin(minimumBid); err != nil {
+		return fmt.Errorf("%s invalid minimum bid: %s", name, err.Error())
+	}
+
+	return nil
+}
+
+func validateAuthorityCommitRevealEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", "AuthorityCommitRevealEnabled", i)
+	}
+
+	return nil
+}
+
+func validateAuthorityRevealDelay(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", "AuthorityRevealDelay", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("%s can't be negative", "AuthorityRevealDelay")
+	}
+
+	return nil
+}
+
+func validateAuthorityRevealWindow(i interface{}) error {
+	return validateDuration("AuthorityRevealWindow", i)
+}
+
 func validateAuthorityAuctionEnabled(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -244,6 +469,27 @@ func validateMinimumBid(i interface{}) error {
 	return validateAmount("MinimumBid", i)
 }
 
+func validateAuctionProceeds(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", "AuctionProceeds", i)
+	}
+
+	policy, err := auction.ParseProceedsPolicy(v)
+	if err != nil {
+		return fmt.Errorf("%s %s", "AuctionProceeds", err.Error())
+	}
+
+	// Name auctions are owned by the nameservice, not the authority claimant.
+	for _, share := range policy {
+		if share.Recipient == auction.ProceedsRecipientOwner {
+			return fmt.Errorf("%s recipient not allowed: %s", "AuctionProceeds", share.Recipient)
+		}
+	}
+
+	return nil
+}
+
 // Validate a set of params.
 func (p Params) Validate() error {
 	if err := validateRecordRent(p.RecordRent); err != nil {
@@ -266,6 +512,38 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateAuthorityRedemptionPeriod(p.AuthorityRedemptionPeriod); err != nil {
+		return err
+	}
+
+	if err := validateAuthorityRedemptionPenalty(p.AuthorityRedemptionPenalty); err != nil {
+		return err
+	}
+
+	if err := validateAuthorityLengthPrices(p.AuthorityLengthPrices); err != nil {
+		return err
+	}
+
+	if err := validateAuthorityPremiumPrices(p.AuthorityPremiumPrices); err != nil {
+		return err
+	}
+
+	if err := validateAuthorityCommitRevealEnabled(p.AuthorityCommitRevealEnabled); err != nil {
+		return err
+	}
+
+	if err := validateAuthorityRevealDelay(p.AuthorityRevealDelay); err != nil {
+		return err
+	}
+
+	if err := validateAuthorityRevealWindow(p.AuthorityRevealWindow); err != nil {
+		return err
+	}
+
+	if p.AuthorityRevealDelay >= p.AuthorityRevealWindow {
+		return fmt.Errorf("AuthorityRevealDelay must be less than AuthorityRevealWindow")
+	}
+
 	if err := validateAuthorityAuctionEnabled(p.AuthorityAuctionEnabled); err != nil {
 		return err
 	}
@@ -290,5 +568,84 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateAuctionProceeds(p.AuctionProceeds); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// GetAuthorityRent returns the rent for an authority.
+// Root authorities are priced by the premium list, then the length table, else AuthorityRent applies.
+func (p Params) GetAuthorityRent(name string) string {
+	if premium := p.getAuthorityPremiumPrice(name); premium != nil {
+		return premium.Rent
+	}
+
+	if lengthPrice := p.getAuthorityLengthPrice(name); lengthPrice != nil {
+		return lengthPrice.Rent
+	}
+
+	return p.AuthorityRent
+}
+
+// GetAuthorityMinimumBid returns the auction minimum bid for an authority.
+func (p Params) GetAuthorityMinimumBid(name string) string {
+	if premium := p.getAuthorityPremiumPrice(name); premium != nil && premium.MinimumBid != "" {
+		return premium.MinimumBid
+	}
+
+	if lengthPrice := p.getAuthorityLengthPrice(name); lengthPrice != nil && lengthPrice.MinimumBid != "" {
+		return lengthPrice.MinimumBid
+	}
+
+	return p.MinimumBid
+}
+
+func (p Params) getAuthorityPremiumPrice(name string) *AuthorityPremiumPrice {
+	if strings.Contains(name, ".") {
+		// Sub-authorities aren't priced by name.
+		return nil
+	}
+
+	for index, price := range p.AuthorityPremiumPrices {
+		if price.Name == name {
+			return &p.AuthorityPremiumPrices[index]
+		}
+	}
+
+	return nil
+}
+
+// getAuthorityLengthPrice returns the price for the smallest max. length that fits the name.
+func (p Params) getAuthorityLengthPrice(name string) *AuthorityLengthPrice {
+	if strings.Contains(name, ".") {
+		return nil
+	}
+
+	length := getNameLength(name)
+
+	var match *AuthorityLengthPrice
+	for index, price := range p.AuthorityLengthPrices {
+		if price.MaxLength >= length && (match == nil || price.MaxLength < match.MaxLength) {
+			match = &p.AuthorityLengthPrices[index]
+		}
+	}
+
+	return match
+}
+
+// getNameLength returns the length of a (punycode) name in characters, i.e. the rune count of its labels in unicode.
+func getNameLength(name string) int64 {
+	var length int64
+	for _, label := range strings.Split(name, ".") {
+		unicodeLabel, err := nameProfile.ToUnicode(label)
+		if err != nil {
+			unicodeLabel = label
+		}
+
+		length += int64(utf8.RuneCountInString(unicodeLabel))
+	}
+
+	return length
+}
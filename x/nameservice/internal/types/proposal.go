@@ -0,0 +1,209 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeNameListChange defines the type for a NameListChangeProposal.
+	ProposalTypeNameListChange = "NameListChange"
+
+	// ProposalTypeAuthorityDispute defines the type for an AuthorityDisputeProposal.
+	ProposalTypeAuthorityDispute = "AuthorityDispute"
+)
+
+// Authority dispute resolution actions.
+const (
+	DisputeActionFreeze   = "freeze"
+	DisputeActionUnfreeze = "unfreeze"
+	DisputeActionRevoke   = "revoke"
+	DisputeActionReassign = "reassign"
+)
+
+// Assert NameListChangeProposal implements govtypes.Content at compile-time.
+var _ govtypes.Content = NameListChangeProposal{}
+
+// Assert AuthorityDisputeProposal implements govtypes.Content at compile-time.
+var _ govtypes.Content = AuthorityDisputeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeNameListChange)
+	govtypes.RegisterProposalTypeCodec(NameListChangeProposal{}, "nameservice/NameListChangeProposal")
+	govtypes.RegisterProposalType(ProposalTypeAuthorityDispute)
+	govtypes.RegisterProposalTypeCodec(AuthorityDisputeProposal{}, "nameservice/AuthorityDisputeProposal")
+}
+
+// ReservedName is a name that can only be reserved by the designated address.
+type ReservedName struct {
+	Name    string `json:"name" yaml:"name"`
+	Address string `json:"address" yaml:"address"`
+}
+
+// NameListChangeProposal updates the governance-managed blocked and reserved name lists.
+type NameListChangeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	// Names to add to/remove from the blocked list.
+	Block   []string `json:"block,omitempty" yaml:"block,omitempty"`
+	Unblock []string `json:"unblock,omitempty" yaml:"unblock,omitempty"`
+
+	// Names to add to/remove from the reserved list.
+	Reserve   []ReservedName `json:"reserve,omitempty" yaml:"reserve,omitempty"`
+	Unreserve []string       `json:"unreserve,omitempty" yaml:"unreserve,omitempty"`
+}
+
+// NewNameListChangeProposal creates a new name list change proposal.
+func NewNameListChangeProposal(title, description string, block, unblock []string, reserve []ReservedName, unreserve []string) NameListChangeProposal {
+	return NameListChangeProposal{
+		Title:       title,
+		Description: description,
+		Block:       block,
+		Unblock:     unblock,
+		Reserve:     reserve,
+		Unreserve:   unreserve,
+	}
+}
+
+// GetTitle returns the title of a name list change proposal.
+func (p NameListChangeProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a name list change proposal.
+func (p NameListChangeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a name list change proposal.
+func (p NameListChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a name list change proposal.
+func (p NameListChangeProposal) ProposalType() string { return ProposalTypeNameListChange }
+
+// ValidateBasic runs basic stateless validity checks.
+func (p NameListChangeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Block)+len(p.Unblock)+len(p.Reserve)+len(p.Unreserve) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "No name list changes.")
+	}
+
+	names := append(append([]string{}, p.Block...), p.Unblock...)
+	names = append(names, p.Unreserve...)
+	for _, reserved := range p.Reserve {
+		if _, err := sdk.AccAddressFromBech32(reserved.Address); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid reserved name address.")
+		}
+
+		names = append(names, reserved.Name)
+	}
+
+	for _, name := range names {
+		if !IsCanonicalName(name) {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Invalid (or non-canonical) name: %s", name))
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p NameListChangeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Name List Change Proposal:
+  Title:       %s
+  Description: %s
+  Block:       %v
+  Unblock:     %v
+  Reserve:     %v
+  Unreserve:   %v
+`, p.Title, p.Description, p.Block, p.Unblock, p.Reserve, p.Unreserve))
+	return b.String()
+}
+
+// AuthorityDisputeProposal freezes, unfreezes, revokes or reassigns an authority (and its sub-authorities).
+type AuthorityDisputeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	// Authority name.
+	Name string `json:"name" yaml:"name"`
+
+	// One of freeze, unfreeze, revoke, reassign.
+	Action string `json:"action" yaml:"action"`
+
+	// New owner address (reassign only).
+	NewOwner string `json:"new_owner,omitempty" yaml:"new_owner,omitempty"`
+}
+
+// NewAuthorityDisputeProposal creates a new authority dispute proposal.
+func NewAuthorityDisputeProposal(title, description, name, action, newOwner string) AuthorityDisputeProposal {
+	return AuthorityDisputeProposal{
+		Title:       title,
+		Description: description,
+		Name:        name,
+		Action:      action,
+		NewOwner:    newOwner,
+	}
+}
+
+// GetTitle returns the title of an authority dispute proposal.
+func (p AuthorityDisputeProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an authority dispute proposal.
+func (p AuthorityDisputeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an authority dispute proposal.
+func (p AuthorityDisputeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an authority dispute proposal.
+func (p AuthorityDisputeProposal) ProposalType() string { return ProposalTypeAuthorityDispute }
+
+// ValidateBasic runs basic stateless validity checks.
+func (p AuthorityDisputeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if !IsCanonicalName(p.Name) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Invalid (or non-canonical) name: %s", p.Name))
+	}
+
+	switch p.Action {
+	case DisputeActionFreeze, DisputeActionUnfreeze, DisputeActionRevoke:
+		if p.NewOwner != "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "New owner is only used to reassign an authority.")
+		}
+	case DisputeActionReassign:
+		if _, err := sdk.AccAddressFromBech32(p.NewOwner); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid new owner address.")
+		}
+	default:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Invalid action: %s", p.Action))
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p AuthorityDisputeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Authority Dispute Proposal:
+  Title:       %s
+  Description: %s
+  Name:        %s
+  Action:      %s
+  New Owner:   %s
+`, p.Title, p.Description, p.Name, p.Action, p.NewOwner))
+	return b.String()
+}
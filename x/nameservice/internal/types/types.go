@@ -6,6 +6,7 @@ package types
 
 import (
 	"crypto/sha256"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +22,12 @@ const (
 	AuthorityActive       AutorityStatus = "active"
 	AuthorityExpired      AutorityStatus = "expired"
 	AuthorityUnderAuction AutorityStatus = "auction"
+
+	// Expired, but can still be restored by the previous owner.
+	AuthorityInRedemption AutorityStatus = "redemption"
+
+	// Frozen by governance (dispute resolution).
+	AuthorityFrozen AutorityStatus = "frozen"
 )
 
 // ID for records.
@@ -36,6 +43,20 @@ type Record struct {
 	Deleted    bool                   `json:"deleted,omitempty"`
 	Owners     []string               `json:"owners,omitempty"`
 	Attributes map[string]interface{} `json:"attributes,omitempty"`
+
+	// Records renew automatically (from the bond) on expiry, unless auto-renewal has been turned off.
+	// Stored as an opt-out, so that existing records keep renewing.
+	DisableAutoRenew bool `json:"disableAutoRenew,omitempty"`
+
+	// Number of owner approvals required for record mutations (0 => 1).
+	OwnerThreshold int64 `json:"ownerThreshold,omitempty"`
+
+	// Rent paid per rent period (of RentDuration), used to refund unused rent.
+	Rent         sdk.Coins     `json:"rent,omitempty"`
+	RentDuration time.Duration `json:"rentDuration,omitempty"`
+
+	// Total rent paid since the record was created, refunds are capped at it.
+	RentPaid sdk.Coins `json:"rentPaid,omitempty"`
 }
 
 // GetBondID returns the BondID of the Record.
@@ -77,6 +98,11 @@ func (r *Record) ToRecordObj() RecordObj {
 	resourceObj.Deleted = r.Deleted
 	resourceObj.Owners = r.Owners
 	resourceObj.Attributes = helpers.MarshalMapToJSONBytes(r.Attributes)
+	resourceObj.DisableAutoRenew = r.DisableAutoRenew
+	resourceObj.OwnerThreshold = r.OwnerThreshold
+	resourceObj.Rent = r.Rent
+	resourceObj.RentDuration = r.RentDuration
+	resourceObj.RentPaid = r.RentPaid
 
 	return resourceObj
 }
@@ -159,13 +185,20 @@ func (payloadObj PayloadObj) ToPayload() Payload {
 
 // RecordObj represents a WNS record.
 type RecordObj struct {
-	ID         ID        `json:"id,omitempty"`
-	BondID     bond.ID   `json:"bondId,omitempty"`
-	CreateTime time.Time `json:"createTime,omitempty"`
-	ExpiryTime time.Time `json:"expiryTime,omitempty"`
-	Deleted    bool      `json:"deleted,omitempty"`
-	Owners     []string  `json:"owners,omitempty"`
-	Attributes []byte    `json:"attributes,omitempty"`
+	ID               ID        `json:"id,omitempty"`
+	BondID           bond.ID   `json:"bondId,omitempty"`
+	CreateTime       time.Time `json:"createTime,omitempty"`
+	ExpiryTime       time.Time `json:"expiryTime,omitempty"`
+	Deleted          bool      `json:"deleted,omitempty"`
+	Owners           []string  `json:"owners,omitempty"`
+	Attributes       []byte    `json:"attributes,omitempty"`
+	DisableAutoRenew bool      `json:"disableAutoRenew,omitempty"`
+
+	OwnerThreshold int64 `json:"ownerThreshold,omitempty"`
+
+	Rent         sdk.Coins     `json:"rent,omitempty"`
+	RentDuration time.Duration `json:"rentDuration,omitempty"`
+	RentPaid     sdk.Coins     `json:"rentPaid,omitempty"`
 }
 
 // ToRecord converts RecordObj to Record.
@@ -180,6 +213,11 @@ func (resourceObj *RecordObj) ToRecord() Record {
 	record.Deleted = resourceObj.Deleted
 	record.Owners = resourceObj.Owners
 	record.Attributes = helpers.UnMarshalMapFromJSONBytes(resourceObj.Attributes)
+	record.DisableAutoRenew = resourceObj.DisableAutoRenew
+	record.OwnerThreshold = resourceObj.OwnerThreshold
+	record.Rent = resourceObj.Rent
+	record.RentDuration = resourceObj.RentDuration
+	record.RentPaid = resourceObj.RentPaid
 
 	return record
 }
@@ -219,6 +257,160 @@ type NameAuthority struct {
 	BondID bond.ID `json:"bondID"`
 
 	ExpiryTime time.Time `json:"expiryTime,omitempty"`
+
+	// M-of-N owner policy, if set, for name updates.
+	OwnerPolicy OwnerPolicy `json:"ownerPolicy,omitempty"`
+
+	// Address of the new owner, if a (two-step) ownership transfer is pending acceptance.
+	PendingOwnerAddress string `json:"pendingOwnerAddress,omitempty"`
+
+	// Policy for third parties reserving sub-authorities.
+	SubAuthorityPolicy SubAuthorityPolicy `json:"subAuthorityPolicy,omitempty"`
+
+	// Status before the authority was frozen, restored when it's unfrozen.
+	FrozenStatus AutorityStatus `json:"frozenStatus,omitempty"`
+}
+
+// SubAuthorityPolicy controls who (other than the owner) can reserve sub-authorities, and at what cost.
+type SubAuthorityPolicy struct {
+	// Anyone can reserve sub-authorities.
+	Open bool `json:"open,omitempty"`
+
+	// Addresses allowed to reserve sub-authorities (if not open).
+	AllowList []string `json:"allowList,omitempty"`
+
+	// Fee per sub-authority, paid to the authority bond (or owner, if no bond).
+	Fee sdk.Coins `json:"fee,omitempty"`
+
+	// Max. depth of sub-authorities below the authority (0 => no limit).
+	MaxDepth int64 `json:"maxDepth,omitempty"`
+}
+
+// Allows returns true if the address can reserve sub-authorities under the policy.
+func (policy SubAuthorityPolicy) Allows(address string) bool {
+	if policy.Open {
+		return true
+	}
+
+	for _, allowed := range policy.AllowList {
+		if allowed == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OwnerPolicy is an M-of-N (threshold) ownership policy.
+type OwnerPolicy struct {
+	// Owner addresses.
+	Owners []string `json:"owners,omitempty"`
+
+	// Number of owner approvals required.
+	Threshold int64 `json:"threshold,omitempty"`
+}
+
+// IsSet returns true if the policy has been set.
+func (policy OwnerPolicy) IsSet() bool {
+	return policy.Threshold > 0
+}
+
+// Approval tracks the owner approvals collected for a pending action.
+type Approval struct {
+	// Action hash.
+	Action string `json:"action"`
+
+	// Owners who have approved the action.
+	Signers []string `json:"signers"`
+
+	// Block height at which the first approval was collected.
+	Height int64 `json:"height"`
+
+	// Authority the action applies to (empty for record actions).
+	Authority string `json:"authority,omitempty"`
+
+	// Approvals not completed by the expiry time are discarded.
+	ExpiryTime time.Time `json:"expiryTime"`
+}
+
+// AuthorityRenewal is the projected expiry time and cost of renewing an authority.
+type AuthorityRenewal struct {
+	Name string `json:"name"`
+
+	// Current expiry time.
+	ExpiryTime time.Time `json:"expiryTime"`
+
+	// Number of rent periods to pay for.
+	Periods int64 `json:"periods"`
+
+	// Expiry time after renewal.
+	ProjectedExpiryTime time.Time `json:"projectedExpiryTime"`
+
+	// Rent for the periods, taken from the authority bond.
+	Cost sdk.Coins `json:"cost"`
+}
+
+// NameLists are the governance-managed blocked and reserved name lists.
+type NameLists struct {
+	Blocked  []string       `json:"blocked"`
+	Reserved []ReservedName `json:"reserved"`
+}
+
+// PermissionSetName allows a grantee to set names.
+const PermissionSetName = "set-name"
+
+// PermissionDeleteName allows a grantee to delete names.
+const PermissionDeleteName = "delete-name"
+
+// PermissionSetAlias allows a grantee to point names at other WRNs.
+const PermissionSetAlias = "set-alias"
+
+// MaxAliasDepth is the max. number of aliases followed when resolving a WRN.
+const MaxAliasDepth = 8
+
+// Grant gives an address (scoped) write access to names under an authority.
+type Grant struct {
+	// Authority name.
+	Authority string `json:"authority"`
+
+	// Grantee address.
+	Grantee string `json:"grantee"`
+
+	// WRN pattern, e.g. wrn://acme/ci/* (a trailing * matches any suffix).
+	Pattern string `json:"pattern"`
+
+	// Granted permissions (e.g. set-name, delete-name).
+	Permissions []string `json:"permissions"`
+
+	// Grant expiry time (zero => no expiry).
+	ExpiryTime time.Time `json:"expiryTime,omitempty"`
+
+	// Block height at which the grant was created.
+	Height int64 `json:"height"`
+}
+
+// Matches returns true if the grant pattern matches the WRN.
+func (grant Grant) Matches(wrn string) bool {
+	if strings.HasSuffix(grant.Pattern, "*") {
+		return strings.HasPrefix(wrn, strings.TrimSuffix(grant.Pattern, "*"))
+	}
+
+	return wrn == grant.Pattern
+}
+
+// Allows returns true if the grant includes the permission and hasn't expired.
+func (grant Grant) Allows(permission string, now time.Time) bool {
+	if !grant.ExpiryTime.IsZero() && !now.Before(grant.ExpiryTime) {
+		return false
+	}
+
+	for _, granted := range grant.Permissions {
+		if granted == permission {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (authority NameAuthority) GetBondID() string {
@@ -234,6 +426,9 @@ type NameRecordEntry struct {
 	// Record ID.
 	ID ID `json:"id"`
 
+	// Target WRN, if the name is an alias (ID is empty).
+	Alias string `json:"alias,omitempty"`
+
 	// Block height at which name record was created.
 	Height int64 `json:"height"`
 }
@@ -255,3 +450,25 @@ type BlockChangeset struct {
 	NameAuthorities []string                 `json:"authorities"`
 	Names           []string                 `json:"names"`
 }
+
+// RecordSchema is a JSON Schema registered for a record type (e.g. `wrn://acme/app`).
+type RecordSchema struct {
+	// Record type (value of the `type` attribute) that the schema applies to, a WRN under the authority.
+	Type string `json:"type"`
+
+	// Authority that owns the record type. Only the authority owner can update the schema.
+	Authority string `json:"authority"`
+
+	// JSON Schema document.
+	Schema string `json:"schema"`
+
+	// Block height at which the schema was last updated.
+	Height int64 `json:"height"`
+}
+
+// AuthorityCommitment is a (salted) hash of a root authority reservation, to be revealed later.
+type AuthorityCommitment struct {
+	CommitHash string    `json:"commitHash"`
+	Signer     string    `json:"signer"`
+	CommitTime time.Time `json:"commitTime"`
+}
@@ -0,0 +1,26 @@
+//
+// Copyright 2020 Wireline, Inc.
+//
+
+package nameservice
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/vulcanize/dxns/x/nameservice/internal/types"
+)
+
+// NewProposalHandler returns a handler for "nameservice" governance proposals.
+func NewProposalHandler(keeper Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.NameListChangeProposal:
+			return keeper.HandleNameListChangeProposal(ctx, c)
+		case types.AuthorityDisputeProposal:
+			return keeper.HandleAuthorityDisputeProposal(ctx, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}